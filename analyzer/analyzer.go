@@ -0,0 +1,369 @@
+// Copyright (c) 2021 Hervé Gouchet. All rights reserved.
+// Use of this source code is governed by the MIT License
+// that can be found in the LICENSE file.
+
+// Package analyzer provides an analyzer reporting the misuses of the enum types generated by genum:
+// switch statements missing constants without default case, and conversions to an enum type
+// whose result is never checked with its IsValid or Validate methods nor overwritten by one of its parsers.
+//
+// It can be used as vet tool with the genumvet command or registered in any go/analysis driver.
+package analyzer
+
+import (
+	"go/ast"
+	"go/types"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/ast/astutil"
+)
+
+// command is the name of the command line generating the enums.
+const command = "genum"
+
+const doc = `check the usage of the enum types generated by genum
+
+The genum analyzer reports switch statements on an enum type that miss
+some of its constants and have no default case, and conversions of
+a variable to an enum type that never pass through its IsValid or
+Validate methods or one of its parsers.`
+
+// Analyzer reports the misuses of the enum types generated by genum.
+var Analyzer = &analysis.Analyzer{
+	Name:      command,
+	Doc:       doc,
+	Run:       run,
+	FactTypes: []analysis.Fact{new(enumFact)},
+}
+
+// enumFact is exported on the type name of each enum generated by genum.
+type enumFact struct {
+	Bitmask   bool
+	Constants []string
+}
+
+// AFact implements the analysis.Fact interface.
+func (*enumFact) AFact() {}
+
+// String implements the fmt.Stringer interface.
+func (f *enumFact) String() string {
+	return command + "(" + strings.Join(f.Constants, ", ") + ")"
+}
+
+func run(pass *analysis.Pass) (interface{}, error) {
+	var files []*ast.File
+	for _, f := range pass.Files {
+		args, ok := generatedBy(f)
+		if !ok {
+			files = append(files, f)
+			continue
+		}
+		exportEnums(pass, f, enumName(args), hasFlag(args, "bitmask"))
+	}
+	for _, f := range files {
+		for _, d := range f.Decls {
+			fn, ok := d.(*ast.FuncDecl)
+			if !ok || fn.Body == nil {
+				continue
+			}
+			checkSwitches(pass, fn.Body)
+			checkConversions(pass, fn.Body)
+		}
+	}
+	return nil, nil
+}
+
+// generatedBy returns the arguments of the genum command line declared in the file header, if any.
+func generatedBy(f *ast.File) (args []string, ok bool) {
+	const (
+		prefix = "// Code generated by "
+		suffix = "; DO NOT EDIT."
+	)
+	for _, cg := range f.Comments {
+		if cg.Pos() > f.Package {
+			break
+		}
+		for _, c := range cg.List {
+			if !strings.HasPrefix(c.Text, prefix) || !strings.HasSuffix(c.Text, suffix) {
+				continue
+			}
+			s, err := strconv.Unquote(strings.TrimSuffix(strings.TrimPrefix(c.Text, prefix), suffix))
+			if err != nil {
+				continue
+			}
+			args = strings.Fields(s)
+			if len(args) > 0 && args[0] == command {
+				return args[1:], true
+			}
+		}
+	}
+	return nil, false
+}
+
+// hasFlag returns true if the boolean flag is enabled in the command line arguments.
+func hasFlag(args []string, name string) bool {
+	for _, a := range args {
+		a = strings.TrimLeft(a, "-")
+		if a == name || a == name+"=true" {
+			return true
+		}
+	}
+	return false
+}
+
+// flagValue returns the value of the flag in the command line arguments, if any.
+func flagValue(args []string, name string) (string, bool) {
+	for k, a := range args {
+		a = strings.TrimLeft(a, "-")
+		if v := strings.TrimPrefix(a, name+"="); v != a {
+			return v, true
+		}
+		if a == name && k+1 < len(args) {
+			return args[k+1], true
+		}
+	}
+	return "", false
+}
+
+// defaultName is the type name of an enum generated without name flag.
+const defaultName = "Enum"
+
+// enumName returns the type name of the enum generated by the command line arguments,
+// as given by the name flag, before its conversion to Pascal case.
+func enumName(args []string) string {
+	name, ok := flagValue(args, "name")
+	if !ok {
+		return defaultName
+	}
+	return name
+}
+
+// isEnumName returns true if the type is named after the name flag, ignoring the case and the separators.
+func isEnumName(typeName, name string) bool {
+	alnum := func(r rune) rune {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			return r
+		}
+		return -1
+	}
+	return strings.EqualFold(strings.Map(alnum, typeName), strings.Map(alnum, name))
+}
+
+// exportEnums exports a fact on the enum type declared in this generated file, listing its constants.
+// The other types of the file, like its group or set types, are not enums.
+func exportEnums(pass *analysis.Pass, f *ast.File, name string, bitmask bool) {
+	for _, d := range f.Decls {
+		gd, ok := d.(*ast.GenDecl)
+		if !ok {
+			continue
+		}
+		for _, s := range gd.Specs {
+			ts, ok := s.(*ast.TypeSpec)
+			if !ok || !isEnumName(ts.Name.Name, name) {
+				continue
+			}
+			tn, ok := pass.TypesInfo.Defs[ts.Name].(*types.TypeName)
+			if !ok {
+				continue
+			}
+			pass.ExportObjectFact(tn, &enumFact{
+				Bitmask:   bitmask,
+				Constants: constants(tn),
+			})
+		}
+	}
+}
+
+// constants returns the names of the constants of this type, in the declaration order.
+func constants(tn *types.TypeName) []string {
+	var (
+		scope = tn.Pkg().Scope()
+		res   []*types.Const
+	)
+	for _, name := range scope.Names() {
+		c, ok := scope.Lookup(name).(*types.Const)
+		if ok && types.Identical(c.Type(), tn.Type()) {
+			res = append(res, c)
+		}
+	}
+	sort.Slice(res, func(i, j int) bool {
+		return res[i].Pos() < res[j].Pos()
+	})
+	names := make([]string, len(res))
+	for k, c := range res {
+		names[k] = c.Name()
+	}
+	return names
+}
+
+// enumOf returns the type name and the fact of the given type if it has been generated by genum.
+func enumOf(pass *analysis.Pass, t types.Type) (*types.TypeName, *enumFact) {
+	named, ok := t.(*types.Named)
+	if !ok {
+		return nil, nil
+	}
+	var (
+		tn   = named.Obj()
+		fact = new(enumFact)
+	)
+	if !pass.ImportObjectFact(tn, fact) {
+		return nil, nil
+	}
+	return tn, fact
+}
+
+// checkSwitches reports the switch statements on an enum type missing constants without default case.
+func checkSwitches(pass *analysis.Pass, body *ast.BlockStmt) {
+	ast.Inspect(body, func(n ast.Node) bool {
+		s, ok := n.(*ast.SwitchStmt)
+		if !ok || s.Tag == nil {
+			return true
+		}
+		tn, fact := enumOf(pass, pass.TypesInfo.TypeOf(s.Tag))
+		if tn == nil || fact.Bitmask {
+			return true
+		}
+		covered := make(map[string]bool)
+		for _, stmt := range s.Body.List {
+			cc, ok := stmt.(*ast.CaseClause)
+			if !ok {
+				continue
+			}
+			if cc.List == nil {
+				// Default case.
+				return true
+			}
+			for _, e := range cc.List {
+				if tv, ok := pass.TypesInfo.Types[e]; ok && tv.Value != nil {
+					covered[tv.Value.ExactString()] = true
+				}
+			}
+		}
+		var missing []string
+		for _, name := range fact.Constants {
+			c, ok := tn.Pkg().Scope().Lookup(name).(*types.Const)
+			if !ok || covered[c.Val().ExactString()] {
+				continue
+			}
+			// Only one constant by value.
+			covered[c.Val().ExactString()] = true
+			missing = append(missing, name)
+		}
+		if len(missing) > 0 {
+			pass.Reportf(
+				s.Pos(), "missing cases in switch of type %s: %s",
+				types.TypeString(tn.Type(), types.RelativeTo(pass.Pkg)), strings.Join(missing, ", "),
+			)
+		}
+		return true
+	})
+}
+
+// checkConversions reports the conversions of a variable to an enum type with a checking method or a parser,
+// when the result is neither directly checked nor assigned to a variable checked or parsed in the same function.
+func checkConversions(pass *analysis.Pass, body *ast.BlockStmt) {
+	var (
+		convs        []*ast.CallExpr
+		assigned     = make(map[*ast.CallExpr]types.Object)
+		checkedCalls = make(map[*ast.CallExpr]bool)
+		checkedVars  = make(map[types.Object]bool)
+	)
+	assign := func(lhs []ast.Expr, rhs []ast.Expr) {
+		if len(lhs) != len(rhs) {
+			return
+		}
+		for k, e := range rhs {
+			call, ok := astutil.Unparen(e).(*ast.CallExpr)
+			if !ok {
+				continue
+			}
+			if id, ok := lhs[k].(*ast.Ident); ok {
+				assigned[call] = pass.TypesInfo.ObjectOf(id)
+			}
+		}
+	}
+	ast.Inspect(body, func(n ast.Node) bool {
+		switch n := n.(type) {
+		case *ast.AssignStmt:
+			assign(n.Lhs, n.Rhs)
+		case *ast.ValueSpec:
+			lhs := make([]ast.Expr, len(n.Names))
+			for k, id := range n.Names {
+				lhs[k] = id
+			}
+			assign(lhs, n.Values)
+		case *ast.CallExpr:
+			if sel, ok := n.Fun.(*ast.SelectorExpr); ok && checks[sel.Sel.Name] {
+				switch x := astutil.Unparen(sel.X).(type) {
+				case *ast.Ident:
+					checkedVars[pass.TypesInfo.ObjectOf(x)] = true
+				case *ast.CallExpr:
+					checkedCalls[x] = true
+				}
+			}
+			if isEnumConversion(pass, n) {
+				convs = append(convs, n)
+			}
+		}
+		return true
+	})
+	for _, call := range convs {
+		if checkedCalls[call] {
+			continue
+		}
+		if obj, ok := assigned[call]; ok && checkedVars[obj] {
+			continue
+		}
+		pass.Reportf(
+			call.Pos(), "unchecked conversion to %s: call IsValid, Validate or use one of its parsers",
+			types.TypeString(pass.TypesInfo.TypeOf(call), types.RelativeTo(pass.Pkg)),
+		)
+	}
+}
+
+const isValid = "IsValid"
+
+// checks lists the methods checking the value of an enum: IsValid, Validate and the parsers overwriting it.
+var checks = map[string]bool{
+	isValid:           true,
+	"Validate":        true,
+	"GobDecode":       true,
+	"ParseLabel":      true,
+	"Set":             true,
+	"UnmarshalBinary": true,
+	"UnmarshalGQL":    true,
+	"UnmarshalJSON":   true,
+	"UnmarshalText":   true,
+	"UnmarshalXML":    true,
+	"UnmarshalYAML":   true,
+}
+
+// isEnumConversion returns true if the call converts a variable to an enum type providing
+// a checking method or a parser.
+func isEnumConversion(pass *analysis.Pass, call *ast.CallExpr) bool {
+	if len(call.Args) != 1 {
+		return false
+	}
+	fn, ok := pass.TypesInfo.Types[call.Fun]
+	if !ok || !fn.IsType() {
+		return false
+	}
+	tn, fact := enumOf(pass, fn.Type)
+	if tn == nil || fact.Bitmask {
+		return false
+	}
+	arg, ok := pass.TypesInfo.Types[call.Args[0]]
+	if !ok || arg.Value != nil || types.Identical(arg.Type, fn.Type) {
+		return false
+	}
+	ms := types.NewMethodSet(types.NewPointer(fn.Type))
+	for k := 0; k < ms.Len(); k++ {
+		if checks[ms.At(k).Obj().Name()] {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,17 @@
+// Copyright (c) 2021 Hervé Gouchet. All rights reserved.
+// Use of this source code is governed by the MIT License
+// that can be found in the LICENSE file.
+
+package analyzer_test
+
+import (
+	"testing"
+
+	"github.com/rvflash/genum/analyzer"
+	"golang.org/x/tools/go/analysis/analysistest"
+)
+
+func TestAnalyzer(t *testing.T) {
+	t.Parallel()
+	analysistest.Run(t, analysistest.TestData(), analyzer.Analyzer, "a", "b")
+}
@@ -0,0 +1,18 @@
+// Copyright (c) 2021 Hervé Gouchet. All rights reserved.
+// Use of this source code is governed by the MIT License
+// that can be found in the LICENSE file.
+
+// Command genumvet checks the usage of the enum types generated by genum.
+// It can be run on its own or as vet tool:
+//
+//	go vet -vettool=$(which genumvet) ./...
+package main
+
+import (
+	"github.com/rvflash/genum/analyzer"
+	"golang.org/x/tools/go/analysis/singlechecker"
+)
+
+func main() {
+	singlechecker.Main(analyzer.Analyzer)
+}
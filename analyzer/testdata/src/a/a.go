@@ -0,0 +1,85 @@
+package a
+
+func label(s Status) string {
+	switch s { // want `missing cases in switch of type Status: Closed`
+	case Pending:
+		return "pending"
+	case Active:
+		return "active"
+	}
+	return ""
+}
+
+func labelWithDefault(s Status) string {
+	switch s {
+	case Pending:
+		return "pending"
+	default:
+		return ""
+	}
+}
+
+func exhaustive(s Status) bool {
+	switch s {
+	case Pending, Active:
+		return true
+	case Closed:
+		return false
+	}
+	return false
+}
+
+func flags(c Config) bool {
+	switch c {
+	case Verbose:
+		return true
+	}
+	return false
+}
+
+func unchecked(i int) Status {
+	return Status(i) // want `unchecked conversion to Status: call IsValid, Validate or use one of its parsers`
+}
+
+func checked(i int) (Status, bool) {
+	s := Status(i)
+	return s, s.IsValid()
+}
+
+func checkedInline(i int) bool {
+	return Status(i).IsValid()
+}
+
+func constant() Status {
+	return Status(2)
+}
+
+func bitmask(i uint8) Config {
+	return Config(i)
+}
+
+func parsed(i int, text []byte) (Status, error) {
+	s := Status(i)
+	err := s.UnmarshalText(text)
+	return s, err
+}
+
+func group(g StatusGroup) string {
+	switch g {
+	case StatusGroupOpen:
+		return "open"
+	}
+	return ""
+}
+
+func validated(i int) (Status, error) {
+	e := Status(i)
+	if err := e.Validate(); err != nil {
+		return Pending, err
+	}
+	return e, nil
+}
+
+func validatedInline(i int) error {
+	return Status(i).Validate()
+}
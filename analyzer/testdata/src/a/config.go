@@ -0,0 +1,69 @@
+// Code generated by "genum -pkg a -name Config -bitmask -validator config.csv"; DO NOT EDIT.
+
+package a
+
+// Config is an enum.
+type Config uint8 // want Config:"genum\\(Verbose, Disk, Debug, Four\\)"
+
+// List of known Config enums.
+const (
+	Verbose Config = 1 << iota
+	Disk
+	Debug
+	Four
+)
+
+// Has returns in success if this Config is set on it.
+func (e Config) Has(e2 Config) bool {
+	return e&e2 != 0
+}
+
+// Set sets this Config on the current Config.
+func (e *Config) Set(e2 Config) {
+	*e |= e2
+}
+
+// Switch only changes the Config value if necessary.
+// It returns true if the requested action has been done.
+func (e *Config) Switch(e2 Config, on bool) (done bool) {
+	if e.Has(e2) == on {
+		return false
+	}
+	if on {
+		e.Set(e2)
+	} else {
+		e.Unset(e2)
+	}
+	return true
+}
+
+// Toggle toggles this Config value.
+func (e *Config) Toggle(e2 Config) {
+	*e ^= e2
+}
+
+// Unset clears this Config value on the current one.
+func (e *Config) Unset(e2 Config) {
+	*e &^= e2
+}
+
+func lookupConfig(e Config) (s string, ok bool) {
+	switch e {
+	case Verbose:
+		return "verbose", true
+	case Disk:
+		return "disk", true
+	case Debug:
+		return "debug", true
+	case Four:
+		return "four", true
+	default:
+		return "", false
+	}
+}
+
+// IsValid returns true if the Config is a known constant.
+func (e Config) IsValid() bool {
+	_, ok := lookupConfig(e)
+	return ok
+}
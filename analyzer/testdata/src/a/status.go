@@ -0,0 +1,136 @@
+// Code generated by "genum -pkg a -name Status -header -validator -stringer -text status.csv"; DO NOT EDIT.
+
+package a
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Status is an enum.
+type Status int // want Status:"genum\\(Pending, Active, Closed\\)"
+
+// List of Status enums in the open group.
+const (
+	Pending Status = 0
+	Active  Status = 1
+)
+
+// List of Status enums in the done group.
+const (
+	Closed Status = 2
+)
+
+// ErrInvalidStatus is returned, wrapped, by the decoders of Status with an invalid value.
+var ErrInvalidStatus = errors.New("invalid Status")
+
+const _StatusNames = "pendingactiveclosed"
+
+var _StatusIndexes = [...]uint8{0, 7, 13, 19}
+
+func lookupStatus(e Status) (s string, ok bool) {
+	if e < 0 || e >= Status(len(_StatusIndexes)-1) {
+		return "", false
+	}
+	return _StatusNames[_StatusIndexes[e]:_StatusIndexes[e+1]], true
+}
+
+// String implements the fmt.Stringer interface.
+func (e Status) String() string {
+	s, ok := lookupStatus(e)
+	if !ok {
+		return fmt.Sprintf("%[3]s(%[2]d)", "", int(e), "Status")
+	}
+	return s
+}
+
+// IsValid returns true if the Status is a known constant.
+func (e Status) IsValid() bool {
+	_, ok := lookupStatus(e)
+	return ok
+}
+
+// Validate returns ErrInvalidStatus if the Status is not a known constant.
+func (e Status) Validate() error {
+	if !e.IsValid() {
+		return fmt.Errorf("%w: unknown %v", ErrInvalidStatus, e)
+	}
+	return nil
+}
+
+// AppendText implements the encoding.TextAppender interface.
+func (e Status) AppendText(b []byte) ([]byte, error) {
+	return append(b, e.String()...), nil
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (e Status) MarshalText() (text []byte, err error) {
+	return e.AppendText(nil)
+}
+
+const _StatusTexts = "activeclosedpending"
+
+var _StatusTextIndexes = [...]uint8{0, 6, 12, 19}
+
+var _StatusTextValues = [...]Status{
+	Active,
+	Closed,
+	Pending,
+}
+
+func parseStatus(text []byte) (e Status, ok bool) {
+	i, j := 0, len(_StatusTextValues)
+	for i < j {
+		h := int(uint(i+j) >> 1)
+		if _StatusTexts[_StatusTextIndexes[h]:_StatusTextIndexes[h+1]] < string(text) {
+			i = h + 1
+		} else {
+			j = h
+		}
+	}
+	if i < len(_StatusTextValues) && _StatusTexts[_StatusTextIndexes[i]:_StatusTextIndexes[i+1]] == string(text) {
+		return _StatusTextValues[i], true
+	}
+	return e, false
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+func (e *Status) UnmarshalText(text []byte) error {
+	e2, ok := parseStatus(text)
+	if !ok {
+		return fmt.Errorf("%w: unknown %q", ErrInvalidStatus, text)
+	}
+	*e = e2
+	return nil
+}
+
+// StatusGroup is a group of Status constants.
+type StatusGroup string
+
+// List of Status groups.
+const (
+	StatusGroupOpen StatusGroup = "open"
+	StatusGroupDone StatusGroup = "done"
+)
+
+// Group returns the group of the Status, empty if unknown or without group.
+func (e Status) Group() StatusGroup {
+	switch e {
+	case Pending, Active:
+		return StatusGroupOpen
+	case Closed:
+		return StatusGroupDone
+	}
+	return ""
+}
+
+// StatusValuesIn returns the Status constants of the group, in their declaration order.
+func StatusValuesIn(group StatusGroup) []Status {
+	switch group {
+	case StatusGroupOpen:
+		return []Status{Pending, Active}
+	case StatusGroupDone:
+		return []Status{Closed}
+	}
+	return nil
+}
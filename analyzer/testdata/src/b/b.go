@@ -0,0 +1,16 @@
+package b
+
+import "a"
+
+func label(s a.Status) string {
+	switch s { // want `missing cases in switch of type a.Status: Pending, Closed`
+	case a.Active:
+		return "active"
+	}
+	return ""
+}
+
+func unchecked(i int) a.Status {
+	var s = a.Status(i) // want `unchecked conversion to a.Status: call IsValid, Validate or use one of its parsers`
+	return s
+}
@@ -0,0 +1,145 @@
+// Copyright (c) 2021 Hervé Gouchet. All rights reserved.
+// Use of this source code is governed by the MIT License
+// that can be found in the LICENSE file.
+
+// Package enum provides generic helpers to work with any enum type generated by genum with the values flag.
+package enum
+
+import (
+	"encoding"
+	"fmt"
+	"sort"
+)
+
+type errEnum string
+
+// Error implements the error interface.
+func (e errEnum) Error() string {
+	return string(e)
+}
+
+// ErrInvalid is returned when a text does not represent any known value.
+const ErrInvalid = errEnum("invalid enum")
+
+// parseError wraps the error of a text unmarshaler, also matching ErrInvalid.
+type parseError struct {
+	err error
+}
+
+// Error implements the error interface.
+func (e parseError) Error() string {
+	return e.err.Error()
+}
+
+// Is returns true with ErrInvalid.
+func (e parseError) Is(target error) bool {
+	return target == ErrInvalid
+}
+
+// Unwrap returns the error of the unmarshaler.
+func (e parseError) Unwrap() error {
+	return e.err
+}
+
+// Enum is implemented by any enum type generated by genum with the values flag.
+type Enum[T any] interface {
+	comparable
+	fmt.Stringer
+	// IsValid returns true if the value is a known constant.
+	IsValid() bool
+	// Values returns the known constants, in their declaration order.
+	Values() []T
+}
+
+// Values returns the known constants of the T enum, in their declaration order.
+func Values[T Enum[T]]() []T {
+	var e T
+	return e.Values()
+}
+
+// IsValid returns true if the value is a known constant of the T enum.
+func IsValid[T Enum[T]](e T) bool {
+	return e.IsValid()
+}
+
+// Parse returns the constant of the T enum represented by the text.
+// The text unmarshaler of the enum is used if implemented, to also parse its aliases, and its error is wrapped.
+// Otherwise, the text is compared with the String representation of each known constant.
+// In both cases, the error matches ErrInvalid.
+func Parse[T Enum[T]](text string) (T, error) {
+	var e T
+	if u, ok := any(&e).(encoding.TextUnmarshaler); ok {
+		err := u.UnmarshalText([]byte(text))
+		if err != nil {
+			return e, parseError{err: err}
+		}
+		return e, nil
+	}
+	for _, v := range e.Values() {
+		if v.String() == text {
+			return v, nil
+		}
+	}
+	return e, fmt.Errorf("%w: unknown %q", ErrInvalid, text)
+}
+
+// Set is a set of constants of the T enum.
+type Set[T Enum[T]] map[T]struct{}
+
+// NewSet returns a set with the given constants.
+func NewSet[T Enum[T]](values ...T) Set[T] {
+	s := make(Set[T], len(values))
+	s.Add(values...)
+	return s
+}
+
+// Add adds the constants to the set.
+func (s Set[T]) Add(values ...T) {
+	for _, v := range values {
+		s[v] = struct{}{}
+	}
+}
+
+// Remove removes the constants from the set.
+func (s Set[T]) Remove(values ...T) {
+	for _, v := range values {
+		delete(s, v)
+	}
+}
+
+// Has returns true if the constant is in the set.
+func (s Set[T]) Has(v T) bool {
+	_, ok := s[v]
+	return ok
+}
+
+// Len returns the number of constants in the set.
+func (s Set[T]) Len() int {
+	return len(s)
+}
+
+// Values returns the constants of the set, known ones first in their declaration order, then the unknown ones
+// sorted by their String representation.
+func (s Set[T]) Values() []T {
+	var (
+		e     T
+		res   = make([]T, 0, len(s))
+		known = make(map[T]struct{}, len(s))
+	)
+	for _, v := range e.Values() {
+		if _, ok := s[v]; ok {
+			res = append(res, v)
+			known[v] = struct{}{}
+		}
+	}
+	n := len(res)
+	for v := range s {
+		if _, ok := known[v]; !ok {
+			res = append(res, v)
+		}
+	}
+	sort.Slice(res[n:], func(i, j int) bool {
+		return res[n+i].String() < res[n+j].String()
+	})
+	return res
+}
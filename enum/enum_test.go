@@ -0,0 +1,115 @@
+// Copyright (c) 2021 Hervé Gouchet. All rights reserved.
+// Use of this source code is governed by the MIT License
+// that can be found in the LICENSE file.
+
+package enum_test
+
+import (
+	"errors"
+	"strconv"
+	"testing"
+
+	"github.com/matryer/is"
+	"github.com/rvflash/genum/enum"
+	values_text "github.com/rvflash/genum/examples/values-text"
+)
+
+// Color is an enum without text unmarshaler.
+type Color int
+
+// List of known Color enums.
+const (
+	Red Color = iota
+	Green
+	Blue
+)
+
+var _ColorNames = [...]string{"red", "green", "blue"}
+
+// String implements the fmt.Stringer interface.
+func (e Color) String() string {
+	if !e.IsValid() {
+		return "Color(" + strconv.Itoa(int(e)) + ")"
+	}
+	return _ColorNames[e]
+}
+
+// IsValid returns true if the Color is a known constant.
+func (e Color) IsValid() bool {
+	return e >= Red && e <= Blue
+}
+
+// Values returns the known constants of Color, in their declaration order.
+func (Color) Values() []Color {
+	return []Color{Red, Green, Blue}
+}
+
+func TestValues(t *testing.T) {
+	t.Parallel()
+	are := is.New(t)
+	are.Equal([]Color{Red, Green, Blue}, enum.Values[Color]()) // mismatch colors
+	are.Equal(
+		[]values_text.Greeting{values_text.Hello, values_text.Bonjour, values_text.Hola, values_text.Ciao},
+		enum.Values[values_text.Greeting](),
+	) // mismatch greetings
+}
+
+func TestIsValid(t *testing.T) {
+	t.Parallel()
+	are := is.New(t)
+	are.True(enum.IsValid(Blue))                       // expected valid
+	are.True(!enum.IsValid(Color(3)))                  // expected invalid
+	are.True(enum.IsValid(values_text.Ciao))           // expected valid
+	are.True(!enum.IsValid(values_text.Greeting(100))) // expected invalid
+}
+
+func TestParse(t *testing.T) {
+	t.Parallel()
+	var (
+		are = is.New(t)
+		dt  = map[string]struct {
+			// inputs
+			in string
+			// outputs
+			color       Color
+			colorErr    bool
+			greeting    values_text.Greeting
+			greetingErr bool
+		}{
+			"Default":  {colorErr: true, greetingErr: true},
+			"Unknown":  {in: "purple", colorErr: true, greetingErr: true},
+			"Color":    {in: "green", color: Green, greetingErr: true},
+			"Greeting": {in: "bonjour", colorErr: true, greeting: values_text.Bonjour},
+			"Alias":    {in: "hey", colorErr: true, greeting: values_text.Hello},
+		}
+	)
+	for name, tt := range dt {
+		tt := tt
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			c, err := enum.Parse[Color](tt.in)
+			are.Equal(tt.colorErr, err != nil)                      // unexpected color error
+			are.Equal(tt.colorErr, errors.Is(err, enum.ErrInvalid)) // expected invalid error
+			are.Equal(tt.color, c)                                  // mismatch color
+			g, err := enum.Parse[values_text.Greeting](tt.in)
+			are.Equal(tt.greetingErr, err != nil)                                     // unexpected greeting error
+			are.Equal(tt.greetingErr, errors.Is(err, enum.ErrInvalid))                // expected invalid error
+			are.Equal(tt.greetingErr, errors.Is(err, values_text.ErrInvalidGreeting)) // expected invalid greeting
+			are.Equal(tt.greeting, g)                                                 // mismatch greeting
+		})
+	}
+}
+
+func TestSet(t *testing.T) {
+	t.Parallel()
+	var (
+		are = is.New(t)
+		s   = enum.NewSet(Blue, Color(4), Red, Color(3))
+	)
+	are.Equal(4, s.Len())                                         // mismatch len
+	are.True(s.Has(Red) && !s.Has(Green))                         // mismatch has
+	are.Equal([]Color{Red, Blue, Color(3), Color(4)}, s.Values()) // mismatch values
+	s.Add(Green)
+	s.Remove(Red, Color(3), Color(4))
+	are.Equal([]Color{Green, Blue}, s.Values()) // mismatch values after update
+}
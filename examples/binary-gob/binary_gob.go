@@ -0,0 +1,11 @@
+// Copyright (c) 2021 Hervé Gouchet. All rights reserved.
+// Use of this source code is governed by the MIT License
+// that can be found in the LICENSE file.
+
+package binary_gob
+
+//go:generate genum -pkg ${GOPACKAGE} -name Code -header -binary code.csv
+//go:generate genum -pkg ${GOPACKAGE} -name Offset -type int16 -header -binary offset.csv
+//go:generate genum -pkg ${GOPACKAGE} -name Rate -type float64 -header -binary rate.csv
+//go:generate genum -pkg ${GOPACKAGE} -name Label -type string -header -binary label.csv
+//go:generate genum -pkg ${GOPACKAGE} -name Perm -bitmask -binary perm.csv
@@ -0,0 +1,109 @@
+// Copyright (c) 2021 Hervé Gouchet. All rights reserved.
+// Use of this source code is governed by the MIT License
+// that can be found in the LICENSE file.
+
+package binary_gob_test
+
+import (
+	"bytes"
+	"encoding"
+	"encoding/gob"
+	"errors"
+	"testing"
+
+	"github.com/matryer/is"
+
+	bg "github.com/rvflash/genum/examples/binary-gob"
+)
+
+type codec interface {
+	encoding.BinaryMarshaler
+	encoding.BinaryUnmarshaler
+}
+
+func TestMarshalBinary(t *testing.T) {
+	t.Parallel()
+	var (
+		are = is.New(t)
+		dt  = map[string]struct {
+			// inputs
+			in  codec
+			out codec
+			// outputs
+			data []byte
+		}{
+			"Int on 8 bytes": {in: ptr(bg.Teapot), out: new(bg.Code), data: []byte{0, 0, 0, 0, 0, 0, 0x01, 0xa2}},
+			"Negative int16": {in: ptr(bg.West), out: new(bg.Offset), data: []byte{0xfe, 0xd4}},
+			"Float64":        {in: ptr(bg.Half), out: new(bg.Rate), data: []byte{0x3f, 0xe0, 0, 0, 0, 0, 0, 0}},
+			"String":         {in: ptr(bg.Beta), out: new(bg.Label), data: []byte("b")},
+			"Bitmask":        {in: ptr(bg.Read | bg.Exec), out: new(bg.Perm), data: []byte{0x05}},
+		}
+	)
+	for name, tt := range dt {
+		tt := tt
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			data, err := tt.in.MarshalBinary()
+			are.NoErr(err)           // unexpected error
+			are.Equal(tt.data, data) // mismatch data
+			err = tt.out.UnmarshalBinary(data)
+			are.NoErr(err)           // unexpected decoding error
+			are.Equal(tt.in, tt.out) // mismatch round trip
+		})
+	}
+}
+
+func TestUnmarshalBinary(t *testing.T) {
+	t.Parallel()
+	var (
+		are = is.New(t)
+		dt  = map[string]struct {
+			// inputs
+			in   []byte
+			into codec
+			// outputs
+			err error
+		}{
+			"Truncated int":     {in: []byte{0x01, 0xa2}, into: new(bg.Code), err: bg.ErrInvalidCode},
+			"Truncated int16":   {in: []byte{0xfe}, into: new(bg.Offset), err: bg.ErrInvalidOffset},
+			"Too long":          {in: []byte{0, 0, 0}, into: new(bg.Offset), err: bg.ErrInvalidOffset},
+			"Empty":             {into: new(bg.Rate), err: bg.ErrInvalidRate},
+			"Unknown int":       {in: []byte{0, 0, 0, 0, 0, 0, 0x01, 0xf4}, into: new(bg.Code), err: bg.ErrInvalidCode},
+			"Unknown string":    {in: []byte("c"), into: new(bg.Label), err: bg.ErrInvalidLabel},
+			"Unknown flag":      {in: []byte{0x08}, into: new(bg.Perm), err: bg.ErrInvalidPerm},
+			"Known flags":       {in: []byte{0x07}, into: new(bg.Perm)},
+			"Known zero offset": {in: []byte{0, 0}, into: new(bg.Offset)},
+		}
+	)
+	for name, tt := range dt {
+		tt := tt
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			err := tt.into.UnmarshalBinary(tt.in)
+			are.True(errors.Is(err, tt.err)) // mismatch error
+		})
+	}
+}
+
+func TestGob(t *testing.T) {
+	t.Parallel()
+	type message struct {
+		Code   bg.Code
+		Offset bg.Offset
+		Label  bg.Label
+		Perm   bg.Perm
+	}
+	var (
+		are = is.New(t)
+		buf bytes.Buffer
+		in  = message{Code: bg.NotFound, Offset: bg.East, Label: bg.Alpha, Perm: bg.Write}
+		out message
+	)
+	are.NoErr(gob.NewEncoder(&buf).Encode(in))   // unexpected encoding error
+	are.NoErr(gob.NewDecoder(&buf).Decode(&out)) // unexpected decoding error
+	are.Equal(in, out)                           // mismatch round trip
+}
+
+func ptr[T any](v T) *T {
+	return &v
+}
@@ -0,0 +1,63 @@
+// Code generated by "genum -pkg binary_gob -name Code -header -binary code.csv"; DO NOT EDIT.
+
+package binary_gob
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Code is an enum.
+type Code int
+
+// List of known Code enums.
+const (
+	Ok       Code = iota + 200
+	NotFound Code = iota + 403
+	Teapot   Code = iota + 416
+)
+
+// ErrInvalidCode is returned, wrapped, by the decoders of Code with an invalid value.
+var ErrInvalidCode = errors.New("invalid Code")
+
+func lookupCode(e Code) (s string, ok bool) {
+	switch e {
+	case Ok:
+		return "ok", true
+	case NotFound:
+		return "not found", true
+	case Teapot:
+		return "teapot", true
+	default:
+		return "", false
+	}
+}
+
+// MarshalBinary implements the encoding.BinaryMarshaler interface.
+func (e Code) MarshalBinary() (data []byte, err error) {
+	v := e
+	return []byte{byte(v >> 56), byte(v >> 48), byte(v >> 40), byte(v >> 32), byte(v >> 24), byte(v >> 16), byte(v >> 8), byte(v)}, nil
+}
+
+// UnmarshalBinary implements the encoding.BinaryUnmarshaler interface.
+func (e *Code) UnmarshalBinary(data []byte) error {
+	if len(data) != 8 {
+		return fmt.Errorf("%w: expects 8 bytes but got %d", ErrInvalidCode, len(data))
+	}
+	v := Code(data[0])<<56 | Code(data[1])<<48 | Code(data[2])<<40 | Code(data[3])<<32 | Code(data[4])<<24 | Code(data[5])<<16 | Code(data[6])<<8 | Code(data[7])
+	if _, ok := lookupCode(v); !ok {
+		return fmt.Errorf("%w: unknown %v", ErrInvalidCode, int(v))
+	}
+	*e = v
+	return nil
+}
+
+// GobEncode implements the gob.GobEncoder interface.
+func (e Code) GobEncode() ([]byte, error) {
+	return e.MarshalBinary()
+}
+
+// GobDecode implements the gob.GobDecoder interface.
+func (e *Code) GobDecode(data []byte) error {
+	return e.UnmarshalBinary(data)
+}
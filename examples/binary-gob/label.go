@@ -0,0 +1,56 @@
+// Code generated by "genum -pkg binary_gob -name Label -type string -header -binary label.csv"; DO NOT EDIT.
+
+package binary_gob
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Label is an enum.
+type Label string
+
+// List of known Label enums.
+const (
+	Alpha Label = "a"
+	Beta  Label = "b"
+)
+
+// ErrInvalidLabel is returned, wrapped, by the decoders of Label with an invalid value.
+var ErrInvalidLabel = errors.New("invalid Label")
+
+func lookupLabel(e Label) (s string, ok bool) {
+	switch e {
+	case Alpha:
+		return "alpha", true
+	case Beta:
+		return "beta", true
+	default:
+		return "", false
+	}
+}
+
+// MarshalBinary implements the encoding.BinaryMarshaler interface.
+func (e Label) MarshalBinary() (data []byte, err error) {
+	return []byte(e), nil
+}
+
+// UnmarshalBinary implements the encoding.BinaryUnmarshaler interface.
+func (e *Label) UnmarshalBinary(data []byte) error {
+	v := Label(data)
+	if _, ok := lookupLabel(v); !ok {
+		return fmt.Errorf("%w: unknown %q", ErrInvalidLabel, string(v))
+	}
+	*e = v
+	return nil
+}
+
+// GobEncode implements the gob.GobEncoder interface.
+func (e Label) GobEncode() ([]byte, error) {
+	return e.MarshalBinary()
+}
+
+// GobDecode implements the gob.GobDecoder interface.
+func (e *Label) GobDecode(data []byte) error {
+	return e.UnmarshalBinary(data)
+}
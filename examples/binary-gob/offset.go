@@ -0,0 +1,63 @@
+// Code generated by "genum -pkg binary_gob -name Offset -type int16 -header -binary offset.csv"; DO NOT EDIT.
+
+package binary_gob
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Offset is an enum.
+type Offset int16
+
+// List of known Offset enums.
+const (
+	West Offset = iota + -300
+	Utc  Offset = iota + -1
+	East Offset = iota + 298
+)
+
+// ErrInvalidOffset is returned, wrapped, by the decoders of Offset with an invalid value.
+var ErrInvalidOffset = errors.New("invalid Offset")
+
+func lookupOffset(e Offset) (s string, ok bool) {
+	switch e {
+	case West:
+		return "west", true
+	case Utc:
+		return "utc", true
+	case East:
+		return "east", true
+	default:
+		return "", false
+	}
+}
+
+// MarshalBinary implements the encoding.BinaryMarshaler interface.
+func (e Offset) MarshalBinary() (data []byte, err error) {
+	v := e
+	return []byte{byte(v >> 8), byte(v)}, nil
+}
+
+// UnmarshalBinary implements the encoding.BinaryUnmarshaler interface.
+func (e *Offset) UnmarshalBinary(data []byte) error {
+	if len(data) != 2 {
+		return fmt.Errorf("%w: expects 2 bytes but got %d", ErrInvalidOffset, len(data))
+	}
+	v := Offset(data[0])<<8 | Offset(data[1])
+	if _, ok := lookupOffset(v); !ok {
+		return fmt.Errorf("%w: unknown %v", ErrInvalidOffset, int16(v))
+	}
+	*e = v
+	return nil
+}
+
+// GobEncode implements the gob.GobEncoder interface.
+func (e Offset) GobEncode() ([]byte, error) {
+	return e.MarshalBinary()
+}
+
+// GobDecode implements the gob.GobDecoder interface.
+func (e *Offset) GobDecode(data []byte) error {
+	return e.UnmarshalBinary(data)
+}
@@ -0,0 +1,97 @@
+// Code generated by "genum -pkg binary_gob -name Perm -bitmask -binary perm.csv"; DO NOT EDIT.
+
+package binary_gob
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Perm is an enum.
+type Perm uint8
+
+// List of known Perm enums.
+const (
+	Read Perm = 1 << iota
+	Write
+	Exec
+)
+
+// ErrInvalidPerm is returned, wrapped, by the decoders of Perm with an invalid value.
+var ErrInvalidPerm = errors.New("invalid Perm")
+
+// Has returns in success if this Perm is set on it.
+func (e Perm) Has(e2 Perm) bool {
+	return e&e2 != 0
+}
+
+// Set sets this Perm on the current Perm.
+func (e *Perm) Set(e2 Perm) {
+	*e |= e2
+}
+
+// Switch only changes the Perm value if necessary.
+// It returns true if the requested action has been done.
+func (e *Perm) Switch(e2 Perm, on bool) (done bool) {
+	if e.Has(e2) == on {
+		return false
+	}
+	if on {
+		e.Set(e2)
+	} else {
+		e.Unset(e2)
+	}
+	return true
+}
+
+// Toggle toggles this Perm value.
+func (e *Perm) Toggle(e2 Perm) {
+	*e ^= e2
+}
+
+// Unset clears this Perm value on the current one.
+func (e *Perm) Unset(e2 Perm) {
+	*e &^= e2
+}
+
+func lookupPerm(e Perm) (s string, ok bool) {
+	switch e {
+	case Read:
+		return "read", true
+	case Write:
+		return "write", true
+	case Exec:
+		return "exec", true
+	default:
+		return "", false
+	}
+}
+
+// MarshalBinary implements the encoding.BinaryMarshaler interface.
+func (e Perm) MarshalBinary() (data []byte, err error) {
+	v := e
+	return []byte{byte(v)}, nil
+}
+
+// UnmarshalBinary implements the encoding.BinaryUnmarshaler interface.
+func (e *Perm) UnmarshalBinary(data []byte) error {
+	if len(data) != 1 {
+		return fmt.Errorf("%w: expects 1 bytes but got %d", ErrInvalidPerm, len(data))
+	}
+	v := Perm(data[0])
+	if v&^0x7 != 0 {
+		return fmt.Errorf("%w: unknown %v", ErrInvalidPerm, uint8(v))
+	}
+	*e = v
+	return nil
+}
+
+// GobEncode implements the gob.GobEncoder interface.
+func (e Perm) GobEncode() ([]byte, error) {
+	return e.MarshalBinary()
+}
+
+// GobDecode implements the gob.GobDecoder interface.
+func (e *Perm) GobDecode(data []byte) error {
+	return e.UnmarshalBinary(data)
+}
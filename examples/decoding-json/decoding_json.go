@@ -0,0 +1,11 @@
+// Copyright (c) 2021 Hervé Gouchet. All rights reserved.
+// Use of this source code is governed by the MIT License
+// that can be found in the LICENSE file.
+
+package decoding_json
+
+//go:generate genum -pkg ${GOPACKAGE} -name Strict -header -prefix -closed -json -text status.csv
+//go:generate genum -pkg ${GOPACKAGE} -name Lenient -header -prefix -open -json -text status.csv
+//go:generate genum -pkg ${GOPACKAGE} -name Fallback -header -prefix -unknown unknown -json -text status.csv
+//go:generate genum -pkg ${GOPACKAGE} -name Tag -type string -prefix -open -json -text tag.csv
+//go:generate genum -pkg ${GOPACKAGE} -name Right -bitmask -prefix -closed -json right.csv
@@ -0,0 +1,159 @@
+// Copyright (c) 2021 Hervé Gouchet. All rights reserved.
+// Use of this source code is governed by the MIT License
+// that can be found in the LICENSE file.
+
+package decoding_json_test
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/matryer/is"
+
+	dj "github.com/rvflash/genum/examples/decoding-json"
+)
+
+func TestStrict(t *testing.T) {
+	t.Parallel()
+	var (
+		are = is.New(t)
+		dt  = map[string]struct {
+			// inputs
+			text, json string
+			// outputs
+			out    dj.Strict
+			failed bool
+		}{
+			"Known":         {text: "active", json: `"2"`, out: dj.StrictActive},
+			"Unknown text":  {text: "oops", json: `"1"`, failed: true},
+			"Unknown value": {text: "pending", json: `"42"`, failed: true},
+			"Invalid value": {text: "pending", json: `"oops"`, failed: true},
+		}
+	)
+	for name, tt := range dt {
+		tt := tt
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			var text, data dj.Strict
+			err1 := text.UnmarshalText([]byte(tt.text))
+			err2 := json.Unmarshal([]byte(tt.json), &data)
+			are.Equal(tt.failed, err1 != nil || err2 != nil) // unexpected error
+			if tt.failed {
+				err := err1
+				if err == nil {
+					err = err2
+				}
+				are.True(errors.Is(err, dj.ErrInvalidStrict)) // mismatch error
+				return
+			}
+			are.Equal(tt.out, text) // mismatch text
+			are.Equal(tt.out, data) // mismatch json
+		})
+	}
+}
+
+func TestLenient(t *testing.T) {
+	t.Parallel()
+	var (
+		are = is.New(t)
+		dt  = map[string]struct {
+			// inputs
+			in string
+			// outputs
+			out    dj.Lenient
+			failed bool
+		}{
+			"Known":   {in: `"2"`, out: dj.LenientActive},
+			"Unknown": {in: `"42"`, out: 42},
+			"Invalid": {in: `"oops"`, failed: true},
+		}
+	)
+	for name, tt := range dt {
+		tt := tt
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			var out dj.Lenient
+			err := json.Unmarshal([]byte(tt.in), &out)
+			are.Equal(tt.failed, err != nil) // unexpected error
+			are.Equal(tt.out, out)           // mismatch value
+		})
+	}
+	// An unknown name has no value to keep.
+	var e dj.Lenient
+	are.True(errors.Is(e.UnmarshalText([]byte("oops")), dj.ErrInvalidLenient)) // expected error
+}
+
+func TestFallback(t *testing.T) {
+	t.Parallel()
+	var (
+		are = is.New(t)
+		dt  = map[string]struct {
+			// inputs
+			text, json string
+			// outputs
+			out dj.Fallback
+		}{
+			"Known":   {text: "active", json: `"2"`, out: dj.FallbackActive},
+			"Unknown": {text: "oops", json: `"42"`, out: dj.FallbackUnknown},
+		}
+	)
+	for name, tt := range dt {
+		tt := tt
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			text, data := dj.FallbackPending, dj.FallbackPending
+			are.NoErr(text.UnmarshalText([]byte(tt.text)))    // unexpected text error
+			are.NoErr(json.Unmarshal([]byte(tt.json), &data)) // unexpected json error
+			are.Equal(tt.out, text)                           // mismatch text
+			are.Equal(tt.out, data)                           // mismatch json
+		})
+	}
+}
+
+func TestTag(t *testing.T) {
+	t.Parallel()
+	var (
+		are = is.New(t)
+		dt  = map[string]dj.Tag{
+			"beta":  dj.TagBeta,
+			"gamma": "gamma",
+		}
+	)
+	for in, out := range dt {
+		var text, data dj.Tag
+		are.NoErr(text.UnmarshalText([]byte(in)))            // unexpected text error
+		are.NoErr(json.Unmarshal([]byte(`"`+in+`"`), &data)) // unexpected json error
+		are.Equal(out, text)                                 // mismatch text
+		are.Equal(out, data)                                 // mismatch json
+	}
+}
+
+func TestRight(t *testing.T) {
+	t.Parallel()
+	var (
+		are = is.New(t)
+		dt  = map[string]struct {
+			// inputs
+			in string
+			// outputs
+			out    dj.Right
+			failed bool
+		}{
+			"Zero":    {in: `"0"`},
+			"Both":    {in: `"3"`, out: dj.RightRead | dj.RightWrite},
+			"Unknown": {in: `"4"`, failed: true},
+			"Mixed":   {in: `"5"`, failed: true},
+		}
+	)
+	for name, tt := range dt {
+		tt := tt
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			var out dj.Right
+			err := json.Unmarshal([]byte(tt.in), &out)
+			are.Equal(tt.failed, errors.Is(err, dj.ErrInvalidRight)) // unexpected error
+			are.Equal(tt.out, out)                                   // mismatch value
+		})
+	}
+}
@@ -0,0 +1,124 @@
+// Code generated by "genum -pkg decoding_json -name Fallback -header -prefix -unknown unknown -json -text status.csv"; DO NOT EDIT.
+
+package decoding_json
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+)
+
+// Fallback is an enum.
+type Fallback int
+
+// List of known Fallback enums.
+const (
+	FallbackUnknown Fallback = iota
+	FallbackPending
+	FallbackActive
+)
+
+// ErrInvalidFallback is returned, wrapped, by the decoders of Fallback with an invalid value.
+var ErrInvalidFallback = errors.New("invalid Fallback")
+
+const _FallbackNames = "unknownpendingactive"
+
+var _FallbackIndexes = [...]uint8{0, 7, 14, 20}
+
+func lookupFallback(e Fallback) (s string, ok bool) {
+	i := uint64(e)
+	if i >= uint64(len(_FallbackIndexes)-1) {
+		return "", false
+	}
+	return _FallbackNames[_FallbackIndexes[i]:_FallbackIndexes[i+1]], true
+}
+
+// String implements the fmt.Stringer interface.
+func (e Fallback) String() string {
+	s, ok := lookupFallback(e)
+	if !ok {
+		return fmt.Sprintf("%[3]s(%[2]d)", "", int(e), "Fallback")
+	}
+	return s
+}
+
+// AppendJSON appends the JSON encoding of the Fallback to b.
+func (e Fallback) AppendJSON(b []byte) ([]byte, error) {
+	b = append(b, '"')
+	b = strconv.AppendInt(b, int64(e), 10)
+	return append(b, '"'), nil
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+func (e Fallback) MarshalJSON() ([]byte, error) {
+	return e.AppendJSON(make([]byte, 0, 24))
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+func (e *Fallback) UnmarshalJSON(data []byte) error {
+	var (
+		s   string
+		err = json.Unmarshal(data, &s)
+	)
+	if err != nil {
+		return fmt.Errorf("%w: expects int but got %s", ErrInvalidFallback, data)
+	}
+	v, err := strconv.ParseInt(s, 10, 64)
+	if err != nil {
+		return fmt.Errorf("%w: expects int but got %s", ErrInvalidFallback, s)
+	}
+	if _, ok := lookupFallback(Fallback(v)); !ok {
+		*e = FallbackUnknown
+		return nil
+	}
+	*e = Fallback(v)
+	return nil
+}
+
+// AppendText implements the encoding.TextAppender interface.
+func (e Fallback) AppendText(b []byte) ([]byte, error) {
+	return append(b, e.String()...), nil
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (e Fallback) MarshalText() (text []byte, err error) {
+	return e.AppendText(nil)
+}
+
+const _FallbackTexts = "activependingunknown"
+
+var _FallbackTextIndexes = [...]uint8{0, 6, 13, 20}
+
+var _FallbackTextValues = [...]Fallback{
+	FallbackActive,
+	FallbackPending,
+	FallbackUnknown,
+}
+
+func parseFallback(text []byte) (e Fallback, ok bool) {
+	i, j := 0, len(_FallbackTextValues)
+	for i < j {
+		h := int(uint(i+j) >> 1)
+		if _FallbackTexts[_FallbackTextIndexes[h]:_FallbackTextIndexes[h+1]] < string(text) {
+			i = h + 1
+		} else {
+			j = h
+		}
+	}
+	if i < len(_FallbackTextValues) && _FallbackTexts[_FallbackTextIndexes[i]:_FallbackTextIndexes[i+1]] == string(text) {
+		return _FallbackTextValues[i], true
+	}
+	return e, false
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+func (e *Fallback) UnmarshalText(text []byte) error {
+	e2, ok := parseFallback(text)
+	if !ok {
+		*e = FallbackUnknown
+		return nil
+	}
+	*e = e2
+	return nil
+}
@@ -0,0 +1,119 @@
+// Code generated by "genum -pkg decoding_json -name Lenient -header -prefix -open -json -text status.csv"; DO NOT EDIT.
+
+package decoding_json
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+)
+
+// Lenient is an enum.
+type Lenient int
+
+// List of known Lenient enums.
+const (
+	LenientUnknown Lenient = iota
+	LenientPending
+	LenientActive
+)
+
+// ErrInvalidLenient is returned, wrapped, by the decoders of Lenient with an invalid value.
+var ErrInvalidLenient = errors.New("invalid Lenient")
+
+const _LenientNames = "unknownpendingactive"
+
+var _LenientIndexes = [...]uint8{0, 7, 14, 20}
+
+func lookupLenient(e Lenient) (s string, ok bool) {
+	i := uint64(e)
+	if i >= uint64(len(_LenientIndexes)-1) {
+		return "", false
+	}
+	return _LenientNames[_LenientIndexes[i]:_LenientIndexes[i+1]], true
+}
+
+// String implements the fmt.Stringer interface.
+func (e Lenient) String() string {
+	s, ok := lookupLenient(e)
+	if !ok {
+		return fmt.Sprintf("%[3]s(%[2]d)", "", int(e), "Lenient")
+	}
+	return s
+}
+
+// AppendJSON appends the JSON encoding of the Lenient to b.
+func (e Lenient) AppendJSON(b []byte) ([]byte, error) {
+	b = append(b, '"')
+	b = strconv.AppendInt(b, int64(e), 10)
+	return append(b, '"'), nil
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+func (e Lenient) MarshalJSON() ([]byte, error) {
+	return e.AppendJSON(make([]byte, 0, 24))
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+func (e *Lenient) UnmarshalJSON(data []byte) error {
+	var (
+		s   string
+		err = json.Unmarshal(data, &s)
+	)
+	if err != nil {
+		return fmt.Errorf("%w: expects int but got %s", ErrInvalidLenient, data)
+	}
+	v, err := strconv.ParseInt(s, 10, 64)
+	if err != nil {
+		return fmt.Errorf("%w: expects int but got %s", ErrInvalidLenient, s)
+	}
+	*e = Lenient(v)
+	return nil
+}
+
+// AppendText implements the encoding.TextAppender interface.
+func (e Lenient) AppendText(b []byte) ([]byte, error) {
+	return append(b, e.String()...), nil
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (e Lenient) MarshalText() (text []byte, err error) {
+	return e.AppendText(nil)
+}
+
+const _LenientTexts = "activependingunknown"
+
+var _LenientTextIndexes = [...]uint8{0, 6, 13, 20}
+
+var _LenientTextValues = [...]Lenient{
+	LenientActive,
+	LenientPending,
+	LenientUnknown,
+}
+
+func parseLenient(text []byte) (e Lenient, ok bool) {
+	i, j := 0, len(_LenientTextValues)
+	for i < j {
+		h := int(uint(i+j) >> 1)
+		if _LenientTexts[_LenientTextIndexes[h]:_LenientTextIndexes[h+1]] < string(text) {
+			i = h + 1
+		} else {
+			j = h
+		}
+	}
+	if i < len(_LenientTextValues) && _LenientTexts[_LenientTextIndexes[i]:_LenientTextIndexes[i+1]] == string(text) {
+		return _LenientTextValues[i], true
+	}
+	return e, false
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+func (e *Lenient) UnmarshalText(text []byte) error {
+	e2, ok := parseLenient(text)
+	if !ok {
+		return fmt.Errorf("%w: unknown %q", ErrInvalidLenient, text)
+	}
+	*e = e2
+	return nil
+}
@@ -0,0 +1,100 @@
+// Code generated by "genum -pkg decoding_json -name Right -bitmask -prefix -closed -json right.csv"; DO NOT EDIT.
+
+package decoding_json
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+)
+
+// Right is an enum.
+type Right uint8
+
+// List of known Right enums.
+const (
+	RightRead Right = 1 << iota
+	RightWrite
+)
+
+// ErrInvalidRight is returned, wrapped, by the decoders of Right with an invalid value.
+var ErrInvalidRight = errors.New("invalid Right")
+
+// Has returns in success if this Right is set on it.
+func (e Right) Has(e2 Right) bool {
+	return e&e2 != 0
+}
+
+// Set sets this Right on the current Right.
+func (e *Right) Set(e2 Right) {
+	*e |= e2
+}
+
+// Switch only changes the Right value if necessary.
+// It returns true if the requested action has been done.
+func (e *Right) Switch(e2 Right, on bool) (done bool) {
+	if e.Has(e2) == on {
+		return false
+	}
+	if on {
+		e.Set(e2)
+	} else {
+		e.Unset(e2)
+	}
+	return true
+}
+
+// Toggle toggles this Right value.
+func (e *Right) Toggle(e2 Right) {
+	*e ^= e2
+}
+
+// Unset clears this Right value on the current one.
+func (e *Right) Unset(e2 Right) {
+	*e &^= e2
+}
+
+const _RightNames = "readwrite"
+
+var _RightIndexes = [...]uint8{0, 4, 9}
+
+func lookupRight(e Right) (s string, ok bool) {
+	i := uint64(e) - 1
+	if i >= uint64(len(_RightIndexes)-1) {
+		return "", false
+	}
+	return _RightNames[_RightIndexes[i]:_RightIndexes[i+1]], true
+}
+
+// AppendJSON appends the JSON encoding of the Right to b.
+func (e Right) AppendJSON(b []byte) ([]byte, error) {
+	b = append(b, '"')
+	b = strconv.AppendInt(b, int64(e), 10)
+	return append(b, '"'), nil
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+func (e Right) MarshalJSON() ([]byte, error) {
+	return e.AppendJSON(make([]byte, 0, 24))
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+func (e *Right) UnmarshalJSON(data []byte) error {
+	var (
+		s   string
+		err = json.Unmarshal(data, &s)
+	)
+	if err != nil {
+		return fmt.Errorf("%w: expects int but got %s", ErrInvalidRight, data)
+	}
+	v, err := strconv.ParseInt(s, 10, 64)
+	if err != nil {
+		return fmt.Errorf("%w: expects int but got %s", ErrInvalidRight, s)
+	}
+	if Right(v)&^0x3 != 0 {
+		return fmt.Errorf("%w: unknown %v", ErrInvalidRight, Right(v))
+	}
+	*e = Right(v)
+	return nil
+}
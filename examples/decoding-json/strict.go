@@ -0,0 +1,122 @@
+// Code generated by "genum -pkg decoding_json -name Strict -header -prefix -closed -json -text status.csv"; DO NOT EDIT.
+
+package decoding_json
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+)
+
+// Strict is an enum.
+type Strict int
+
+// List of known Strict enums.
+const (
+	StrictUnknown Strict = iota
+	StrictPending
+	StrictActive
+)
+
+// ErrInvalidStrict is returned, wrapped, by the decoders of Strict with an invalid value.
+var ErrInvalidStrict = errors.New("invalid Strict")
+
+const _StrictNames = "unknownpendingactive"
+
+var _StrictIndexes = [...]uint8{0, 7, 14, 20}
+
+func lookupStrict(e Strict) (s string, ok bool) {
+	i := uint64(e)
+	if i >= uint64(len(_StrictIndexes)-1) {
+		return "", false
+	}
+	return _StrictNames[_StrictIndexes[i]:_StrictIndexes[i+1]], true
+}
+
+// String implements the fmt.Stringer interface.
+func (e Strict) String() string {
+	s, ok := lookupStrict(e)
+	if !ok {
+		return fmt.Sprintf("%[3]s(%[2]d)", "", int(e), "Strict")
+	}
+	return s
+}
+
+// AppendJSON appends the JSON encoding of the Strict to b.
+func (e Strict) AppendJSON(b []byte) ([]byte, error) {
+	b = append(b, '"')
+	b = strconv.AppendInt(b, int64(e), 10)
+	return append(b, '"'), nil
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+func (e Strict) MarshalJSON() ([]byte, error) {
+	return e.AppendJSON(make([]byte, 0, 24))
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+func (e *Strict) UnmarshalJSON(data []byte) error {
+	var (
+		s   string
+		err = json.Unmarshal(data, &s)
+	)
+	if err != nil {
+		return fmt.Errorf("%w: expects int but got %s", ErrInvalidStrict, data)
+	}
+	v, err := strconv.ParseInt(s, 10, 64)
+	if err != nil {
+		return fmt.Errorf("%w: expects int but got %s", ErrInvalidStrict, s)
+	}
+	if _, ok := lookupStrict(Strict(v)); !ok {
+		return fmt.Errorf("%w: unknown %v", ErrInvalidStrict, Strict(v))
+	}
+	*e = Strict(v)
+	return nil
+}
+
+// AppendText implements the encoding.TextAppender interface.
+func (e Strict) AppendText(b []byte) ([]byte, error) {
+	return append(b, e.String()...), nil
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (e Strict) MarshalText() (text []byte, err error) {
+	return e.AppendText(nil)
+}
+
+const _StrictTexts = "activependingunknown"
+
+var _StrictTextIndexes = [...]uint8{0, 6, 13, 20}
+
+var _StrictTextValues = [...]Strict{
+	StrictActive,
+	StrictPending,
+	StrictUnknown,
+}
+
+func parseStrict(text []byte) (e Strict, ok bool) {
+	i, j := 0, len(_StrictTextValues)
+	for i < j {
+		h := int(uint(i+j) >> 1)
+		if _StrictTexts[_StrictTextIndexes[h]:_StrictTextIndexes[h+1]] < string(text) {
+			i = h + 1
+		} else {
+			j = h
+		}
+	}
+	if i < len(_StrictTextValues) && _StrictTexts[_StrictTextIndexes[i]:_StrictTextIndexes[i+1]] == string(text) {
+		return _StrictTextValues[i], true
+	}
+	return e, false
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+func (e *Strict) UnmarshalText(text []byte) error {
+	e2, ok := parseStrict(text)
+	if !ok {
+		return fmt.Errorf("%w: unknown %q", ErrInvalidStrict, text)
+	}
+	*e = e2
+	return nil
+}
@@ -0,0 +1,118 @@
+// Code generated by "genum -pkg decoding_json -name Tag -type string -prefix -open -json -text tag.csv"; DO NOT EDIT.
+
+package decoding_json
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+)
+
+// Tag is an enum.
+type Tag string
+
+// List of known Tag enums.
+const (
+	TagAlpha Tag = "alpha"
+	TagBeta  Tag = "beta"
+)
+
+// ErrInvalidTag is returned, wrapped, by the decoders of Tag with an invalid value.
+var ErrInvalidTag = errors.New("invalid Tag")
+
+func lookupTag(e Tag) (s string, ok bool) {
+	switch e {
+	case TagAlpha:
+		return "alpha", true
+	case TagBeta:
+		return "beta", true
+	default:
+		return "", false
+	}
+}
+
+// String implements the fmt.Stringer interface.
+func (e Tag) String() string {
+	s, ok := lookupTag(e)
+	if !ok {
+		return fmt.Sprintf("%[3]s(%[2]q)", "", string(e), "Tag")
+	}
+	return s
+}
+
+// AppendJSON appends the JSON encoding of the Tag to b.
+func (e Tag) AppendJSON(b []byte) ([]byte, error) {
+	for i := 0; i < len(e); i++ {
+		if c := e[i]; c < 0x20 || c >= 0x7f || c == '"' || c == '\\' || c == '<' || c == '>' || c == '&' {
+			data, err := json.Marshal(string(e))
+			return append(b, data...), err
+		}
+	}
+	b = append(b, '"')
+	b = append(b, e...)
+	return append(b, '"'), nil
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+func (e Tag) MarshalJSON() ([]byte, error) {
+	return e.AppendJSON(make([]byte, 0, 24))
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+func (e *Tag) UnmarshalJSON(data []byte) error {
+	var (
+		s   string
+		err = json.Unmarshal(data, &s)
+	)
+	if err != nil {
+		return fmt.Errorf("%w: expects string but got %s", ErrInvalidTag, data)
+	}
+	*e = Tag(s)
+	return nil
+}
+
+// AppendText implements the encoding.TextAppender interface.
+func (e Tag) AppendText(b []byte) ([]byte, error) {
+	return append(b, e.String()...), nil
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (e Tag) MarshalText() (text []byte, err error) {
+	return e.AppendText(nil)
+}
+
+const _TagTexts = "alphabeta"
+
+var _TagTextIndexes = [...]uint8{0, 5, 9}
+
+var _TagTextValues = [...]Tag{
+	TagAlpha,
+	TagBeta,
+}
+
+func parseTag(text []byte) (e Tag, ok bool) {
+	i, j := 0, len(_TagTextValues)
+	for i < j {
+		h := int(uint(i+j) >> 1)
+		if _TagTexts[_TagTextIndexes[h]:_TagTextIndexes[h+1]] < string(text) {
+			i = h + 1
+		} else {
+			j = h
+		}
+	}
+	if i < len(_TagTextValues) && _TagTexts[_TagTextIndexes[i]:_TagTextIndexes[i+1]] == string(text) {
+		return _TagTextValues[i], true
+	}
+	return e, false
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+func (e *Tag) UnmarshalText(text []byte) error {
+	e2, ok := parseTag(text)
+	if !ok {
+		*e = Tag(text)
+		return nil
+	}
+	*e = e2
+	return nil
+}
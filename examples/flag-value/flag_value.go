@@ -0,0 +1,8 @@
+// Copyright (c) 2021 Hervé Gouchet. All rights reserved.
+// Use of this source code is governed by the MIT License
+// that can be found in the LICENSE file.
+
+package flag_value
+
+//go:generate genum -pkg ${GOPACKAGE} -name Permission -bitmask -flag permission.csv
+//go:generate genum -pkg ${GOPACKAGE} -name Level -header -flag level.csv
@@ -0,0 +1,92 @@
+// Copyright (c) 2021 Hervé Gouchet. All rights reserved.
+// Use of this source code is governed by the MIT License
+// that can be found in the LICENSE file.
+
+package flag_value_test
+
+import (
+	"flag"
+	"io"
+	"testing"
+
+	"github.com/matryer/is"
+
+	fv "github.com/rvflash/genum/examples/flag-value"
+)
+
+func TestPermissionValue_Set(t *testing.T) {
+	t.Parallel()
+	var (
+		are = is.New(t)
+		dt  = map[string]struct {
+			// inputs
+			in string
+			// outputs
+			out    fv.Permission
+			text   string
+			failed bool
+		}{
+			"Default": {},
+			"One":     {in: "write", out: fv.Write, text: "write"},
+			"Comma":   {in: "read,exec", out: fv.Read | fv.Exec, text: "read,exec"},
+			"Pipe":    {in: "read|write", out: fv.Read | fv.Write, text: "read,write"},
+			"Mixed":   {in: "exec | read, write", out: fv.Read | fv.Write | fv.Exec, text: "read,write,exec"},
+			"Twice":   {in: "read|read", out: fv.Read, text: "read"},
+			"Unknown": {in: "read|oops", failed: true},
+		}
+	)
+	for name, tt := range dt {
+		tt := tt
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			var (
+				p  fv.Permission
+				fs = flag.NewFlagSet("test", flag.ContinueOnError)
+			)
+			fs.SetOutput(io.Discard)
+			fs.Var(p.FlagValue(), "perm", fv.PermissionFlagUsage)
+			err := fs.Parse([]string{"-perm", tt.in})
+			are.Equal(tt.failed, err != nil) // unexpected error
+			if err != nil {
+				return
+			}
+			are.Equal(tt.out, p)                          // mismatch permission
+			are.Equal(tt.text, p.FlagValue().String())    // mismatch string
+			are.Equal("Permission", p.FlagValue().Type()) // mismatch type
+		})
+	}
+}
+
+func TestLevel_Set(t *testing.T) {
+	t.Parallel()
+	var (
+		are = is.New(t)
+		dt  = map[string]struct {
+			// inputs
+			in string
+			// outputs
+			out    fv.Level
+			failed bool
+		}{
+			"OK":      {in: "info", out: fv.Info},
+			"Unknown": {in: "oops", failed: true},
+			"List":    {in: "info,error", failed: true},
+		}
+	)
+	for name, tt := range dt {
+		tt := tt
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			var (
+				l  fv.Level
+				fs = flag.NewFlagSet("test", flag.ContinueOnError)
+			)
+			fs.SetOutput(io.Discard)
+			fs.Var(&l, "level", fv.LevelFlagUsage)
+			err := fs.Parse([]string{"-level", tt.in})
+			are.Equal(tt.failed, err != nil) // unexpected error
+			are.Equal(tt.out, l)             // mismatch level
+			are.Equal("Level", l.Type())     // mismatch type
+		})
+	}
+}
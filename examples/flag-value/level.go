@@ -0,0 +1,101 @@
+// Code generated by "genum -pkg flag_value -name Level -header -flag level.csv"; DO NOT EDIT.
+
+package flag_value
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Level is an enum.
+type Level int
+
+// List of known Level enums.
+const (
+	Debug Level = iota + 1
+	Info
+	Error
+)
+
+// ErrInvalidLevel is returned, wrapped, by the decoders of Level with an invalid value.
+var ErrInvalidLevel = errors.New("invalid Level")
+
+const _LevelNames = "debuginfoerror"
+
+var _LevelIndexes = [...]uint8{0, 5, 9, 14}
+
+func lookupLevel(e Level) (s string, ok bool) {
+	i := uint64(e) - 1
+	if i >= uint64(len(_LevelIndexes)-1) {
+		return "", false
+	}
+	return _LevelNames[_LevelIndexes[i]:_LevelIndexes[i+1]], true
+}
+
+// String implements the fmt.Stringer interface.
+func (e Level) String() string {
+	s, ok := lookupLevel(e)
+	if !ok {
+		return fmt.Sprintf("%[3]s(%[2]d)", "", int(e), "Level")
+	}
+	return s
+}
+
+// AppendText implements the encoding.TextAppender interface.
+func (e Level) AppendText(b []byte) ([]byte, error) {
+	return append(b, e.String()...), nil
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (e Level) MarshalText() (text []byte, err error) {
+	return e.AppendText(nil)
+}
+
+const _LevelTexts = "debugerrorinfo"
+
+var _LevelTextIndexes = [...]uint8{0, 5, 10, 14}
+
+var _LevelTextValues = [...]Level{
+	Debug,
+	Error,
+	Info,
+}
+
+func parseLevel(text []byte) (e Level, ok bool) {
+	i, j := 0, len(_LevelTextValues)
+	for i < j {
+		h := int(uint(i+j) >> 1)
+		if _LevelTexts[_LevelTextIndexes[h]:_LevelTextIndexes[h+1]] < string(text) {
+			i = h + 1
+		} else {
+			j = h
+		}
+	}
+	if i < len(_LevelTextValues) && _LevelTexts[_LevelTextIndexes[i]:_LevelTextIndexes[i+1]] == string(text) {
+		return _LevelTextValues[i], true
+	}
+	return e, false
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+func (e *Level) UnmarshalText(text []byte) error {
+	e2, ok := parseLevel(text)
+	if !ok {
+		return fmt.Errorf("%w: unknown %q", ErrInvalidLevel, text)
+	}
+	*e = e2
+	return nil
+}
+
+// LevelFlagUsage lists the values allowed on the command line.
+const LevelFlagUsage = "one of \"debug\", \"info\", \"error\""
+
+// Set implements the flag.Value interface.
+func (e *Level) Set(s string) error {
+	return e.UnmarshalText([]byte(s))
+}
+
+// Type implements the pflag.Value interface.
+func (e *Level) Type() string {
+	return "Level"
+}
@@ -0,0 +1,169 @@
+// Code generated by "genum -pkg flag_value -name Permission -bitmask -flag permission.csv"; DO NOT EDIT.
+
+package flag_value
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// Permission is an enum.
+type Permission uint8
+
+// List of known Permission enums.
+const (
+	Read Permission = 1 << iota
+	Write
+	Exec
+)
+
+// ErrInvalidPermission is returned, wrapped, by the decoders of Permission with an invalid value.
+var ErrInvalidPermission = errors.New("invalid Permission")
+
+// Has returns in success if this Permission is set on it.
+func (e Permission) Has(e2 Permission) bool {
+	return e&e2 != 0
+}
+
+// Set sets this Permission on the current Permission.
+func (e *Permission) Set(e2 Permission) {
+	*e |= e2
+}
+
+// Switch only changes the Permission value if necessary.
+// It returns true if the requested action has been done.
+func (e *Permission) Switch(e2 Permission, on bool) (done bool) {
+	if e.Has(e2) == on {
+		return false
+	}
+	if on {
+		e.Set(e2)
+	} else {
+		e.Unset(e2)
+	}
+	return true
+}
+
+// Toggle toggles this Permission value.
+func (e *Permission) Toggle(e2 Permission) {
+	*e ^= e2
+}
+
+// Unset clears this Permission value on the current one.
+func (e *Permission) Unset(e2 Permission) {
+	*e &^= e2
+}
+
+func lookupPermission(e Permission) (s string, ok bool) {
+	switch e {
+	case Read:
+		return "read", true
+	case Write:
+		return "write", true
+	case Exec:
+		return "exec", true
+	default:
+		return "", false
+	}
+}
+
+// String implements the fmt.Stringer interface.
+func (e Permission) String() string {
+	s, ok := lookupPermission(e)
+	if !ok {
+		return fmt.Sprintf("%[3]s(%[2]d)", "", int(e), "Permission")
+	}
+	return s
+}
+
+// AppendText implements the encoding.TextAppender interface.
+func (e Permission) AppendText(b []byte) ([]byte, error) {
+	return append(b, e.String()...), nil
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (e Permission) MarshalText() (text []byte, err error) {
+	return e.AppendText(nil)
+}
+
+const _PermissionTexts = "execreadwrite"
+
+var _PermissionTextIndexes = [...]uint8{0, 4, 8, 13}
+
+var _PermissionTextValues = [...]Permission{
+	Exec,
+	Read,
+	Write,
+}
+
+func parsePermission(text []byte) (e Permission, ok bool) {
+	i, j := 0, len(_PermissionTextValues)
+	for i < j {
+		h := int(uint(i+j) >> 1)
+		if _PermissionTexts[_PermissionTextIndexes[h]:_PermissionTextIndexes[h+1]] < string(text) {
+			i = h + 1
+		} else {
+			j = h
+		}
+	}
+	if i < len(_PermissionTextValues) && _PermissionTexts[_PermissionTextIndexes[i]:_PermissionTextIndexes[i+1]] == string(text) {
+		return _PermissionTextValues[i], true
+	}
+	return e, false
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+func (e *Permission) UnmarshalText(text []byte) error {
+	e2, ok := parsePermission(text)
+	if !ok {
+		return fmt.Errorf("%w: unknown %q", ErrInvalidPermission, text)
+	}
+	*e = e2
+	return nil
+}
+
+// PermissionFlagUsage lists the values allowed on the command line.
+const PermissionFlagUsage = "comma or pipe separated list of \"read\", \"write\", \"exec\""
+
+// PermissionValue implements the flag.Value and pflag.Value interfaces on a Permission.
+type PermissionValue Permission
+
+// FlagValue returns the Permission as a value to set with a command line flag.
+func (e *Permission) FlagValue() *PermissionValue {
+	return (*PermissionValue)(e)
+}
+
+// Set implements the flag.Value interface.
+func (e *PermissionValue) Set(s string) error {
+	var v Permission
+	for _, f := range strings.FieldsFunc(s, func(r rune) bool { return r == ',' || r == '|' }) {
+		var e2 Permission
+		err := e2.UnmarshalText([]byte(strings.TrimSpace(f)))
+		if err != nil {
+			return err
+		}
+		v.Set(e2)
+	}
+	*e = PermissionValue(v)
+	return nil
+}
+
+// String implements the flag.Value interface.
+func (e *PermissionValue) String() string {
+	if e == nil {
+		return ""
+	}
+	var names []string
+	for i := 0; i < 3; i++ {
+		if v := Permission(1) << i; Permission(*e).Has(v) {
+			names = append(names, v.String())
+		}
+	}
+	return strings.Join(names, ",")
+}
+
+// Type implements the pflag.Value interface.
+func (e *PermissionValue) Type() string {
+	return "Permission"
+}
@@ -0,0 +1,7 @@
+// Copyright (c) 2021 Hervé Gouchet. All rights reserved.
+// Use of this source code is governed by the MIT License
+// that can be found in the LICENSE file.
+
+package group_text
+
+//go:generate genum -pkg ${GOPACKAGE} -type uint16 -name HTTPStatus -header -text http_status.csv
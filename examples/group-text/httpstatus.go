@@ -0,0 +1,153 @@
+// Code generated by "genum -pkg group_text -type uint16 -name HTTPStatus -header -text http_status.csv"; DO NOT EDIT.
+
+package group_text
+
+import (
+	"errors"
+	"fmt"
+)
+
+// HTTPStatus is an enum.
+type HTTPStatus uint16
+
+// List of HTTPStatus enums in the success group.
+const (
+	Ok       HTTPStatus = 200
+	Created  HTTPStatus = 201
+	Accepted HTTPStatus = 202
+)
+
+// List of HTTPStatus enums in the client_error group.
+const (
+	BadRequest   HTTPStatus = 400
+	Unauthorized HTTPStatus = 401
+	NotFound     HTTPStatus = 404
+)
+
+// List of HTTPStatus enums in the server_error group.
+const (
+	InternalServerError HTTPStatus = 500
+	ServiceUnavailable  HTTPStatus = 503
+)
+
+// ErrInvalidHTTPStatus is returned, wrapped, by the decoders of HTTPStatus with an invalid value.
+var ErrInvalidHTTPStatus = errors.New("invalid HTTPStatus")
+
+func lookupHTTPStatus(e HTTPStatus) (s string, ok bool) {
+	switch e {
+	case Ok:
+		return "ok", true
+	case Created:
+		return "created", true
+	case Accepted:
+		return "accepted", true
+	case BadRequest:
+		return "bad_request", true
+	case Unauthorized:
+		return "unauthorized", true
+	case NotFound:
+		return "not_found", true
+	case InternalServerError:
+		return "internal_server_error", true
+	case ServiceUnavailable:
+		return "service_unavailable", true
+	default:
+		return "", false
+	}
+}
+
+// String implements the fmt.Stringer interface.
+func (e HTTPStatus) String() string {
+	s, ok := lookupHTTPStatus(e)
+	if !ok {
+		return fmt.Sprintf("%[3]s(%[2]d)", "", uint16(e), "HTTPStatus")
+	}
+	return s
+}
+
+// AppendText implements the encoding.TextAppender interface.
+func (e HTTPStatus) AppendText(b []byte) ([]byte, error) {
+	return append(b, e.String()...), nil
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (e HTTPStatus) MarshalText() (text []byte, err error) {
+	return e.AppendText(nil)
+}
+
+const _HTTPStatusTexts = "acceptedbad_requestcreatedinternal_server_errornot_foundokservice_unavailableunauthorized"
+
+var _HTTPStatusTextIndexes = [...]uint8{0, 8, 19, 26, 47, 56, 58, 77, 89}
+
+var _HTTPStatusTextValues = [...]HTTPStatus{
+	Accepted,
+	BadRequest,
+	Created,
+	InternalServerError,
+	NotFound,
+	Ok,
+	ServiceUnavailable,
+	Unauthorized,
+}
+
+func parseHTTPStatus(text []byte) (e HTTPStatus, ok bool) {
+	i, j := 0, len(_HTTPStatusTextValues)
+	for i < j {
+		h := int(uint(i+j) >> 1)
+		if _HTTPStatusTexts[_HTTPStatusTextIndexes[h]:_HTTPStatusTextIndexes[h+1]] < string(text) {
+			i = h + 1
+		} else {
+			j = h
+		}
+	}
+	if i < len(_HTTPStatusTextValues) && _HTTPStatusTexts[_HTTPStatusTextIndexes[i]:_HTTPStatusTextIndexes[i+1]] == string(text) {
+		return _HTTPStatusTextValues[i], true
+	}
+	return e, false
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+func (e *HTTPStatus) UnmarshalText(text []byte) error {
+	e2, ok := parseHTTPStatus(text)
+	if !ok {
+		return fmt.Errorf("%w: unknown %q", ErrInvalidHTTPStatus, text)
+	}
+	*e = e2
+	return nil
+}
+
+// HTTPStatusGroup is a group of HTTPStatus constants.
+type HTTPStatusGroup string
+
+// List of HTTPStatus groups.
+const (
+	HTTPStatusGroupSuccess     HTTPStatusGroup = "success"
+	HTTPStatusGroupClientError HTTPStatusGroup = "client_error"
+	HTTPStatusGroupServerError HTTPStatusGroup = "server_error"
+)
+
+// Group returns the group of the HTTPStatus, empty if unknown or without group.
+func (e HTTPStatus) Group() HTTPStatusGroup {
+	switch e {
+	case Ok, Created, Accepted:
+		return HTTPStatusGroupSuccess
+	case BadRequest, Unauthorized, NotFound:
+		return HTTPStatusGroupClientError
+	case InternalServerError, ServiceUnavailable:
+		return HTTPStatusGroupServerError
+	}
+	return ""
+}
+
+// HTTPStatusValuesIn returns the HTTPStatus constants of the group, in their declaration order.
+func HTTPStatusValuesIn(group HTTPStatusGroup) []HTTPStatus {
+	switch group {
+	case HTTPStatusGroupSuccess:
+		return []HTTPStatus{Ok, Created, Accepted}
+	case HTTPStatusGroupClientError:
+		return []HTTPStatus{BadRequest, Unauthorized, NotFound}
+	case HTTPStatusGroupServerError:
+		return []HTTPStatus{InternalServerError, ServiceUnavailable}
+	}
+	return nil
+}
@@ -0,0 +1,49 @@
+// Copyright (c) 2021 Hervé Gouchet. All rights reserved.
+// Use of this source code is governed by the MIT License
+// that can be found in the LICENSE file.
+
+package group_text_test
+
+import (
+	"testing"
+
+	"github.com/matryer/is"
+
+	group "github.com/rvflash/genum/examples/group-text"
+)
+
+func TestHTTPStatus_AppendText(t *testing.T) {
+	t.Parallel()
+	var (
+		are = is.New(t)
+		dt  = map[string]struct {
+			in  group.HTTPStatus
+			out string
+		}{
+			"Default": {out: "HTTPStatus(0)"},
+			"Known":   {in: group.NotFound, out: "not_found"},
+			"Unknown": {in: 418, out: "HTTPStatus(418)"},
+		}
+	)
+	for name, tt := range dt {
+		tt := tt
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			text, err := tt.in.MarshalText()
+			are.NoErr(err)                  // unexpected marshal error
+			are.Equal(tt.out, string(text)) // mismatch marshal
+			text, err = tt.in.AppendText([]byte("v="))
+			are.NoErr(err)                       // unexpected append error
+			are.Equal("v="+tt.out, string(text)) // mismatch append
+		})
+	}
+}
+
+func TestHTTPStatus_AppendText_Allocs(t *testing.T) {
+	are := is.New(t)
+	b := make([]byte, 0, 64)
+	n := testing.AllocsPerRun(100, func() {
+		b, _ = group.ServiceUnavailable.AppendText(b[:0])
+	})
+	are.Equal(float64(0), n) // unexpected allocation
+}
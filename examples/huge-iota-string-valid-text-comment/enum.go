@@ -3,6 +3,7 @@
 package huge_iota_string_valid_text_comment
 
 import (
+	"errors"
 	"fmt"
 )
 
@@ -27,16 +28,27 @@ const (
 	A                        // e = 18
 )
 
+// ErrInvalidEnum is returned, wrapped, by the decoders of Enum with an invalid value.
+var ErrInvalidEnum = errors.New("invalid Enum")
+
 const _EnumNames = "hellobonjourhalloholaciaoOssuyasouSalamZdravoSalutusubh dinPakaWatdiA"
 
-var _EnumIndexes = [...]uint8{0, 5, 12, 17, 21, 25, 29, 34, 39, 45, 51, 59, 63, 68, 69}
+var (
+	_EnumIndexes_0 = [...]uint8{0, 5, 12, 17, 21, 25, 29, 34}
+	_EnumIndexes_1 = [...]uint8{34, 39, 45, 51, 59, 63, 68, 69}
+)
 
 func lookupEnum(e Enum) (s string, ok bool) {
-	e -= -4
-	if e < 0 || e >= Enum(len(_EnumIndexes)-1) {
+	switch {
+	case -4 <= e && e <= 2:
+		i := int64(e) - (-4)
+		return _EnumNames[_EnumIndexes_0[i]:_EnumIndexes_0[i+1]], true
+	case 12 <= e && e <= 18:
+		e -= 12
+		return _EnumNames[_EnumIndexes_1[e]:_EnumIndexes_1[e+1]], true
+	default:
 		return "", false
 	}
-	return _EnumNames[_EnumIndexes[e]:_EnumIndexes[e+1]], true
 }
 
 // String implements the fmt.Stringer interface.
@@ -54,33 +66,66 @@ func (e Enum) IsValid() bool {
 	return ok
 }
 
+// Validate returns ErrInvalidEnum if the Enum is not a known constant.
+func (e Enum) Validate() error {
+	if !e.IsValid() {
+		return fmt.Errorf("%w: unknown %v", ErrInvalidEnum, e)
+	}
+	return nil
+}
+
+// AppendText implements the encoding.TextAppender interface.
+func (e Enum) AppendText(b []byte) ([]byte, error) {
+	return append(b, e.String()...), nil
+}
+
 // MarshalText implements the encoding.TextMarshaler interface.
 func (e Enum) MarshalText() (text []byte, err error) {
-	return []byte(e.String()), nil
+	return e.AppendText(nil)
 }
 
-var _EnumStrings = map[string]Enum{
-	"hello":    Hello,
-	"bonjour":  Bonjour,
-	"hallo":    Hallo,
-	"hola":     Hola,
-	"ciao":     Ciao,
-	"Ossu":     Ossu,
-	"yasou":    Yasou,
-	"Salam":    Salam,
-	"Zdravo":   Zdravo,
-	"Salutu":   Salutu,
-	"subh din": SubhDin,
-	"Paka":     Paka,
-	"Watdi":    Watdi,
-	"A":        A,
+const _EnumTexts = "AOssuPakaSalamSalutuWatdiZdravobonjourciaohallohelloholasubh dinyasou"
+
+var _EnumTextIndexes = [...]uint8{0, 1, 5, 9, 14, 20, 25, 31, 38, 42, 47, 52, 56, 64, 69}
+
+var _EnumTextValues = [...]Enum{
+	A,
+	Ossu,
+	Paka,
+	Salam,
+	Salutu,
+	Watdi,
+	Zdravo,
+	Bonjour,
+	Ciao,
+	Hallo,
+	Hello,
+	Hola,
+	SubhDin,
+	Yasou,
+}
+
+func parseEnum(text []byte) (e Enum, ok bool) {
+	i, j := 0, len(_EnumTextValues)
+	for i < j {
+		h := int(uint(i+j) >> 1)
+		if _EnumTexts[_EnumTextIndexes[h]:_EnumTextIndexes[h+1]] < string(text) {
+			i = h + 1
+		} else {
+			j = h
+		}
+	}
+	if i < len(_EnumTextValues) && _EnumTexts[_EnumTextIndexes[i]:_EnumTextIndexes[i+1]] == string(text) {
+		return _EnumTextValues[i], true
+	}
+	return e, false
 }
 
 // UnmarshalText implements the encoding.TextUnmarshaler interface.
 func (e *Enum) UnmarshalText(text []byte) error {
-	e2, ok := _EnumStrings[string(text)]
+	e2, ok := parseEnum(text)
 	if !ok {
-		return fmt.Errorf("%q is not a known Enum", text)
+		return fmt.Errorf("%w: unknown %q", ErrInvalidEnum, text)
 	}
 	*e = e2
 	return nil
@@ -3,6 +3,7 @@
 package one_column_only
 
 import (
+	"errors"
 	"fmt"
 )
 
@@ -20,16 +21,19 @@ const (
 	Yasou
 )
 
+// ErrInvalidEnum is returned, wrapped, by the decoders of Enum with an invalid value.
+var ErrInvalidEnum = errors.New("invalid Enum")
+
 const _EnumNames = "hellobonjourhalloholaciaoOssuyasou"
 
 var _EnumIndexes = [...]uint8{0, 5, 12, 17, 21, 25, 29, 34}
 
 func lookupEnum(e Enum) (s string, ok bool) {
-	e -= 1
-	if e < 0 || e >= Enum(len(_EnumIndexes)-1) {
+	i := uint64(e)
+	if i >= uint64(len(_EnumIndexes)-1) {
 		return "", false
 	}
-	return _EnumNames[_EnumIndexes[e]:_EnumIndexes[e+1]], true
+	return _EnumNames[_EnumIndexes[i]:_EnumIndexes[i+1]], true
 }
 
 // String implements the fmt.Stringer interface.
@@ -46,3 +50,11 @@ func (e Enum) IsValid() bool {
 	_, ok := lookupEnum(e)
 	return ok
 }
+
+// Validate returns ErrInvalidEnum if the Enum is not a known constant.
+func (e Enum) Validate() error {
+	if !e.IsValid() {
+		return fmt.Errorf("%w: unknown %v", ErrInvalidEnum, e)
+	}
+	return nil
+}
@@ -0,0 +1,49 @@
+// Code generated by "genum -pkg lookup_signed -name Altitude -type int16 -header -stringer -lookup runs altitude.csv"; DO NOT EDIT.
+
+package lookup_signed
+
+import (
+	"fmt"
+)
+
+// Altitude is an enum.
+type Altitude int16
+
+// List of known Altitude enums.
+const (
+	Abyss Altitude = iota + -32000
+	Trench
+	SeaLevel Altitude = iota + -2
+	Summit   Altitude = iota + 31997
+)
+
+const _AltitudeNames = "abysstrenchsea_levelsummit"
+
+var (
+	_AltitudeIndexes_0 = [...]uint8{0, 5, 11}
+	_AltitudeIndexes_1 = [...]uint8{11, 20}
+	_AltitudeIndexes_2 = [...]uint8{20, 26}
+)
+
+func lookupAltitude(e Altitude) (s string, ok bool) {
+	switch {
+	case -32000 <= e && e <= -31999:
+		i := int64(e) - (-32000)
+		return _AltitudeNames[_AltitudeIndexes_0[i]:_AltitudeIndexes_0[i+1]], true
+	case e == 0:
+		return _AltitudeNames[_AltitudeIndexes_1[0]:_AltitudeIndexes_1[1]], true
+	case e == 32000:
+		return _AltitudeNames[_AltitudeIndexes_2[0]:_AltitudeIndexes_2[1]], true
+	default:
+		return "", false
+	}
+}
+
+// String implements the fmt.Stringer interface.
+func (e Altitude) String() string {
+	s, ok := lookupAltitude(e)
+	if !ok {
+		return fmt.Sprintf("%[3]s(%[2]d)", "", int16(e), "Altitude")
+	}
+	return s
+}
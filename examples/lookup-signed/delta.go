@@ -0,0 +1,240 @@
+// Code generated by "genum -pkg lookup_signed -name Delta -type int8 -header -stringer delta.csv"; DO NOT EDIT.
+
+package lookup_signed
+
+import (
+	"fmt"
+)
+
+// Delta is an enum.
+type Delta int8
+
+// List of known Delta enums.
+const (
+	Minus100 Delta = iota + -100
+	Minus99
+	Minus98
+	Minus97
+	Minus96
+	Minus95
+	Minus94
+	Minus93
+	Minus92
+	Minus91
+	Minus90
+	Minus89
+	Minus88
+	Minus87
+	Minus86
+	Minus85
+	Minus84
+	Minus83
+	Minus82
+	Minus81
+	Minus80
+	Minus79
+	Minus78
+	Minus77
+	Minus76
+	Minus75
+	Minus74
+	Minus73
+	Minus72
+	Minus71
+	Minus70
+	Minus69
+	Minus68
+	Minus67
+	Minus66
+	Minus65
+	Minus64
+	Minus63
+	Minus62
+	Minus61
+	Minus60
+	Minus59
+	Minus58
+	Minus57
+	Minus56
+	Minus55
+	Minus54
+	Minus53
+	Minus52
+	Minus51
+	Minus50
+	Minus49
+	Minus48
+	Minus47
+	Minus46
+	Minus45
+	Minus44
+	Minus43
+	Minus42
+	Minus41
+	Minus40
+	Minus39
+	Minus38
+	Minus37
+	Minus36
+	Minus35
+	Minus34
+	Minus33
+	Minus32
+	Minus31
+	Minus30
+	Minus29
+	Minus28
+	Minus27
+	Minus26
+	Minus25
+	Minus24
+	Minus23
+	Minus22
+	Minus21
+	Minus20
+	Minus19
+	Minus18
+	Minus17
+	Minus16
+	Minus15
+	Minus14
+	Minus13
+	Minus12
+	Minus11
+	Minus10
+	Minus9
+	Minus8
+	Minus7
+	Minus6
+	Minus5
+	Minus4
+	Minus3
+	Minus2
+	Minus1
+	Zero
+	Plus1
+	Plus2
+	Plus3
+	Plus4
+	Plus5
+	Plus6
+	Plus7
+	Plus8
+	Plus9
+	Plus10
+	Plus11
+	Plus12
+	Plus13
+	Plus14
+	Plus15
+	Plus16
+	Plus17
+	Plus18
+	Plus19
+	Plus20
+	Plus21
+	Plus22
+	Plus23
+	Plus24
+	Plus25
+	Plus26
+	Plus27
+	Plus28
+	Plus29
+	Plus30
+	Plus31
+	Plus32
+	Plus33
+	Plus34
+	Plus35
+	Plus36
+	Plus37
+	Plus38
+	Plus39
+	Plus40
+	Plus41
+	Plus42
+	Plus43
+	Plus44
+	Plus45
+	Plus46
+	Plus47
+	Plus48
+	Plus49
+	Plus50
+	Plus51
+	Plus52
+	Plus53
+	Plus54
+	Plus55
+	Plus56
+	Plus57
+	Plus58
+	Plus59
+	Plus60
+	Plus61
+	Plus62
+	Plus63
+	Plus64
+	Plus65
+	Plus66
+	Plus67
+	Plus68
+	Plus69
+	Plus70
+	Plus71
+	Plus72
+	Plus73
+	Plus74
+	Plus75
+	Plus76
+	Plus77
+	Plus78
+	Plus79
+	Plus80
+	Plus81
+	Plus82
+	Plus83
+	Plus84
+	Plus85
+	Plus86
+	Plus87
+	Plus88
+	Plus89
+	Plus90
+	Plus91
+	Plus92
+	Plus93
+	Plus94
+	Plus95
+	Plus96
+	Plus97
+	Plus98
+	Plus99
+	Plus100
+)
+
+const _DeltaNames = "minus_100minus_99minus_98minus_97minus_96minus_95minus_94minus_93minus_92minus_91minus_90minus_89minus_88minus_87minus_86minus_85minus_84minus_83minus_82minus_81minus_80minus_79minus_78minus_77minus_76minus_75minus_74minus_73minus_72minus_71minus_70minus_69minus_68minus_67minus_66minus_65minus_64minus_63minus_62minus_61minus_60minus_59minus_58minus_57minus_56minus_55minus_54minus_53minus_52minus_51minus_50minus_49minus_48minus_47minus_46minus_45minus_44minus_43minus_42minus_41minus_40minus_39minus_38minus_37minus_36minus_35minus_34minus_33minus_32minus_31minus_30minus_29minus_28minus_27minus_26minus_25minus_24minus_23minus_22minus_21minus_20minus_19minus_18minus_17minus_16minus_15minus_14minus_13minus_12minus_11minus_10minus_9minus_8minus_7minus_6minus_5minus_4minus_3minus_2minus_1zeroplus_1plus_2plus_3plus_4plus_5plus_6plus_7plus_8plus_9plus_10plus_11plus_12plus_13plus_14plus_15plus_16plus_17plus_18plus_19plus_20plus_21plus_22plus_23plus_24plus_25plus_26plus_27plus_28plus_29plus_30plus_31plus_32plus_33plus_34plus_35plus_36plus_37plus_38plus_39plus_40plus_41plus_42plus_43plus_44plus_45plus_46plus_47plus_48plus_49plus_50plus_51plus_52plus_53plus_54plus_55plus_56plus_57plus_58plus_59plus_60plus_61plus_62plus_63plus_64plus_65plus_66plus_67plus_68plus_69plus_70plus_71plus_72plus_73plus_74plus_75plus_76plus_77plus_78plus_79plus_80plus_81plus_82plus_83plus_84plus_85plus_86plus_87plus_88plus_89plus_90plus_91plus_92plus_93plus_94plus_95plus_96plus_97plus_98plus_99plus_100"
+
+var (
+	_DeltaIndexes_0 = [...]uint16{0, 9, 17, 25, 33, 41, 49, 57, 65, 73, 81, 89, 97, 105, 113, 121, 129, 137, 145, 153, 161, 169, 177, 185, 193, 201, 209, 217, 225, 233, 241, 249, 257, 265, 273, 281, 289, 297, 305, 313, 321, 329, 337, 345, 353, 361, 369, 377, 385, 393, 401, 409, 417, 425, 433, 441, 449, 457, 465, 473, 481, 489, 497, 505, 513, 521, 529, 537, 545, 553, 561, 569, 577, 585, 593, 601, 609, 617, 625, 633, 641, 649, 657, 665, 673, 681, 689, 697, 705, 713, 721, 729, 736, 743, 750, 757, 764, 771, 778, 785, 792, 796, 802, 808, 814, 820, 826, 832, 838, 844, 850, 857, 864, 871, 878, 885, 892, 899, 906, 913, 920, 927, 934, 941, 948, 955, 962, 969, 976, 983, 990, 997, 1004, 1011, 1018, 1025, 1032, 1039, 1046, 1053, 1060, 1067, 1074, 1081, 1088, 1095, 1102, 1109, 1116, 1123, 1130, 1137, 1144, 1151, 1158, 1165, 1172, 1179, 1186, 1193, 1200, 1207, 1214, 1221, 1228, 1235, 1242, 1249, 1256, 1263, 1270, 1277, 1284, 1291, 1298, 1305, 1312, 1319, 1326, 1333, 1340, 1347, 1354, 1361, 1368, 1375, 1382, 1389, 1396, 1403, 1410, 1417, 1424, 1431, 1438, 1445, 1452, 1459, 1466, 1473, 1480, 1488}
+)
+
+func lookupDelta(e Delta) (s string, ok bool) {
+	switch {
+	case -100 <= e && e <= 100:
+		i := int64(e) - (-100)
+		return _DeltaNames[_DeltaIndexes_0[i]:_DeltaIndexes_0[i+1]], true
+	default:
+		return "", false
+	}
+}
+
+// String implements the fmt.Stringer interface.
+func (e Delta) String() string {
+	s, ok := lookupDelta(e)
+	if !ok {
+		return fmt.Sprintf("%[3]s(%[2]d)", "", int8(e), "Delta")
+	}
+	return s
+}
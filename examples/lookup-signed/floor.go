@@ -0,0 +1,41 @@
+// Code generated by "genum -pkg lookup_signed -name Floor -type int16 -header -stringer floor.csv"; DO NOT EDIT.
+
+package lookup_signed
+
+import (
+	"fmt"
+)
+
+// Floor is an enum.
+type Floor int16
+
+// List of known Floor enums.
+const (
+	Parking Floor = iota + -3
+	Cellar
+	Basement
+	Ground
+	First
+	Second
+)
+
+const _FloorNames = "parkingcellarbasementgroundfirstsecond"
+
+var _FloorIndexes = [...]uint8{0, 7, 13, 21, 27, 32, 38}
+
+func lookupFloor(e Floor) (s string, ok bool) {
+	i := uint64(int64(e) - (-3))
+	if i >= uint64(len(_FloorIndexes)-1) {
+		return "", false
+	}
+	return _FloorNames[_FloorIndexes[i]:_FloorIndexes[i+1]], true
+}
+
+// String implements the fmt.Stringer interface.
+func (e Floor) String() string {
+	s, ok := lookupFloor(e)
+	if !ok {
+		return fmt.Sprintf("%[3]s(%[2]d)", "", int16(e), "Floor")
+	}
+	return s
+}
@@ -0,0 +1,10 @@
+// Copyright (c) 2021 Hervé Gouchet. All rights reserved.
+// Use of this source code is governed by the MIT License
+// that can be found in the LICENSE file.
+
+package lookup_signed
+
+//go:generate genum -pkg ${GOPACKAGE} -name Temperature -type int8 -header -stringer -lookup array temperature.csv
+//go:generate genum -pkg ${GOPACKAGE} -name Altitude -type int16 -header -stringer -lookup runs altitude.csv
+//go:generate genum -pkg ${GOPACKAGE} -name Floor -type int16 -header -stringer floor.csv
+//go:generate genum -pkg ${GOPACKAGE} -name Delta -type int8 -header -stringer delta.csv
@@ -0,0 +1,93 @@
+// Copyright (c) 2021 Hervé Gouchet. All rights reserved.
+// Use of this source code is governed by the MIT License
+// that can be found in the LICENSE file.
+
+package lookup_signed_test
+
+import (
+	"math"
+	"strconv"
+	"testing"
+
+	"github.com/matryer/is"
+
+	signed "github.com/rvflash/genum/examples/lookup-signed"
+)
+
+func TestTemperature_String(t *testing.T) {
+	t.Parallel()
+	var (
+		are = is.New(t)
+		dt  = map[signed.Temperature]string{
+			signed.Cold:     "cold",
+			signed.Chilly:   "chilly",
+			signed.Mild:     "mild",
+			signed.Hot:      "hot",
+			-101:            "Temperature(-101)",
+			-99:             "Temperature(-99)",
+			math.MinInt8:    "Temperature(-128)",
+			math.MaxInt8:    "Temperature(127)",
+			signed.Warm + 1: "Temperature(51)",
+		}
+	)
+	for in, out := range dt {
+		are.Equal(out, in.String()) // mismatch string
+	}
+}
+
+func TestDelta_String(t *testing.T) {
+	t.Parallel()
+	are := is.New(t)
+	for i := math.MinInt8; i <= math.MaxInt8; i++ {
+		var out string
+		switch {
+		case i < -100 || i > 100:
+			out = "Delta(" + strconv.Itoa(i) + ")"
+		case i < 0:
+			out = "minus_" + strconv.Itoa(-i)
+		case i == 0:
+			out = "zero"
+		default:
+			out = "plus_" + strconv.Itoa(i)
+		}
+		are.Equal(out, signed.Delta(i).String()) // mismatch string
+	}
+}
+
+func TestAltitude_String(t *testing.T) {
+	t.Parallel()
+	var (
+		are = is.New(t)
+		dt  = map[signed.Altitude]string{
+			signed.Abyss:    "abyss",
+			signed.Trench:   "trench",
+			signed.SeaLevel: "sea_level",
+			signed.Summit:   "summit",
+			-31998:          "Altitude(-31998)",
+			math.MinInt16:   "Altitude(-32768)",
+			math.MaxInt16:   "Altitude(32767)",
+		}
+	)
+	for in, out := range dt {
+		are.Equal(out, in.String()) // mismatch string
+	}
+}
+
+func TestFloor_String(t *testing.T) {
+	t.Parallel()
+	var (
+		are = is.New(t)
+		dt  = map[signed.Floor]string{
+			signed.Parking: "parking",
+			signed.Ground:  "ground",
+			signed.Second:  "second",
+			-4:             "Floor(-4)",
+			3:              "Floor(3)",
+			math.MinInt16:  "Floor(-32768)",
+			math.MaxInt16:  "Floor(32767)",
+		}
+	)
+	for in, out := range dt {
+		are.Equal(out, in.String()) // mismatch string
+	}
+}
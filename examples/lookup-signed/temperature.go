@@ -0,0 +1,41 @@
+// Code generated by "genum -pkg lookup_signed -name Temperature -type int8 -header -stringer -lookup array temperature.csv"; DO NOT EDIT.
+
+package lookup_signed
+
+import (
+	"fmt"
+)
+
+// Temperature is an enum.
+type Temperature int8
+
+// List of known Temperature enums.
+const (
+	Cold   Temperature = iota + -100
+	Chilly Temperature = iota + -51
+	Mild   Temperature = iota + -2
+	Warm   Temperature = iota + 47
+	Hot    Temperature = iota + 96
+)
+
+const _TemperatureNames = "coldchillymildwarmhot"
+
+var _TemperatureIndexes = [...]uint8{0, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 21}
+
+func lookupTemperature(e Temperature) (s string, ok bool) {
+	i := uint64(int64(e) - (-100))
+	if i >= uint64(len(_TemperatureIndexes)-1) {
+		return "", false
+	}
+	s = _TemperatureNames[_TemperatureIndexes[i]:_TemperatureIndexes[i+1]]
+	return s, s != ""
+}
+
+// String implements the fmt.Stringer interface.
+func (e Temperature) String() string {
+	s, ok := lookupTemperature(e)
+	if !ok {
+		return fmt.Sprintf("%[3]s(%[2]d)", "", int8(e), "Temperature")
+	}
+	return s
+}
@@ -0,0 +1,100 @@
+// Code generated by "genum -pkg metadata_json -name HTTPError -header -json http_error.csv"; DO NOT EDIT.
+
+package metadata_json
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+)
+
+// HTTPError is an enum.
+type HTTPError int
+
+// List of known HTTPError enums.
+const (
+	NotFound HTTPError = iota + 1
+	Conflict
+	Internal
+	Unavailable
+)
+
+// ErrInvalidHTTPError is returned, wrapped, by the decoders of HTTPError with an invalid value.
+var ErrInvalidHTTPError = errors.New("invalid HTTPError")
+
+// AppendJSON appends the JSON encoding of the HTTPError to b.
+func (e HTTPError) AppendJSON(b []byte) ([]byte, error) {
+	b = append(b, '"')
+	b = strconv.AppendInt(b, int64(e), 10)
+	return append(b, '"'), nil
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+func (e HTTPError) MarshalJSON() ([]byte, error) {
+	return e.AppendJSON(make([]byte, 0, 24))
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+func (e *HTTPError) UnmarshalJSON(data []byte) error {
+	var (
+		s   string
+		err = json.Unmarshal(data, &s)
+	)
+	if err != nil {
+		return fmt.Errorf("%w: expects int but got %s", ErrInvalidHTTPError, data)
+	}
+	v, err := strconv.ParseInt(s, 10, 64)
+	if err != nil {
+		return fmt.Errorf("%w: expects int but got %s", ErrInvalidHTTPError, s)
+	}
+	*e = HTTPError(v)
+	return nil
+}
+
+func metaIndexHTTPError(e HTTPError) int {
+	switch e {
+	case NotFound:
+		return 0
+	case Conflict:
+		return 1
+	case Internal:
+		return 2
+	case Unavailable:
+		return 3
+	default:
+		return -1
+	}
+}
+
+var _HTTPErrorStatusValues = [...]int{404, 409, 500, 503}
+
+// Status returns the status of the HTTPError, its zero value if unknown.
+func (e HTTPError) Status() int {
+	k := metaIndexHTTPError(e)
+	if k < 0 {
+		return 0
+	}
+	return _HTTPErrorStatusValues[k]
+}
+
+var _HTTPErrorRetryableValues = [...]bool{false, true, false, true}
+
+// Retryable returns the retryable of the HTTPError, its zero value if unknown.
+func (e HTTPError) Retryable() bool {
+	k := metaIndexHTTPError(e)
+	return k >= 0 && _HTTPErrorRetryableValues[k]
+}
+
+const _HTTPErrorCategoryValues = "clientclientserverserver"
+
+var _HTTPErrorCategoryIndexes = [...]uint8{0, 6, 12, 18, 24}
+
+// Category returns the category of the HTTPError, its zero value if unknown.
+func (e HTTPError) Category() string {
+	k := metaIndexHTTPError(e)
+	if k < 0 {
+		return ""
+	}
+	return _HTTPErrorCategoryValues[_HTTPErrorCategoryIndexes[k]:_HTTPErrorCategoryIndexes[k+1]]
+}
@@ -0,0 +1,55 @@
+// Copyright (c) 2021 Hervé Gouchet. All rights reserved.
+// Use of this source code is governed by the MIT License
+// that can be found in the LICENSE file.
+
+package metadata_json_test
+
+import (
+	"encoding/json"
+	"strconv"
+	"testing"
+
+	"github.com/matryer/is"
+
+	metadata "github.com/rvflash/genum/examples/metadata-json"
+)
+
+func TestHTTPError_AppendJSON(t *testing.T) {
+	t.Parallel()
+	var (
+		are = is.New(t)
+		dt  = map[string]struct {
+			in  metadata.HTTPError
+			out string
+		}{
+			"Default": {out: `"0"`},
+			"Known":   {in: metadata.Conflict, out: `"2"`},
+			"Unknown": {in: 42, out: `"42"`},
+		}
+	)
+	for name, tt := range dt {
+		tt := tt
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			data, err := tt.in.MarshalJSON()
+			are.NoErr(err)                  // unexpected marshal error
+			are.Equal(tt.out, string(data)) // mismatch marshal
+			// Same encoding as the one of its string value by the json package.
+			std, err := json.Marshal(strconv.Itoa(int(tt.in)))
+			are.NoErr(err)                 // unexpected json error
+			are.Equal(string(std), tt.out) // mismatch json
+			data, err = tt.in.AppendJSON([]byte("v="))
+			are.NoErr(err)                       // unexpected append error
+			are.Equal("v="+tt.out, string(data)) // mismatch append
+		})
+	}
+}
+
+func TestHTTPError_AppendJSON_Allocs(t *testing.T) {
+	are := is.New(t)
+	b := make([]byte, 0, 64)
+	n := testing.AllocsPerRun(100, func() {
+		b, _ = metadata.Unavailable.AppendJSON(b[:0])
+	})
+	are.Equal(float64(0), n) // unexpected allocation
+}
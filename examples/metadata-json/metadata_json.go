@@ -0,0 +1,7 @@
+// Copyright (c) 2021 Hervé Gouchet. All rights reserved.
+// Use of this source code is governed by the MIT License
+// that can be found in the LICENSE file.
+
+package metadata_json
+
+//go:generate genum -pkg ${GOPACKAGE} -name HTTPError -header -json http_error.csv
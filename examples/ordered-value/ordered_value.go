@@ -0,0 +1,9 @@
+// Copyright (c) 2021 Hervé Gouchet. All rights reserved.
+// Use of this source code is governed by the MIT License
+// that can be found in the LICENSE file.
+
+package ordered_value
+
+//go:generate genum -pkg ${GOPACKAGE} -name Priority -header -stringer -ordered -order value priority.csv
+//go:generate genum -pkg ${GOPACKAGE} -name Urgency -header -prefix -stringer -ordered -order csv priority.csv
+//go:generate genum -pkg ${GOPACKAGE} -name Stage -type uint8 -iota -stringer -ordered -order csv stage.csv
@@ -0,0 +1,103 @@
+// Code generated by "genum -pkg ordered_value -name Priority -header -stringer -ordered -order value priority.csv"; DO NOT EDIT.
+
+package ordered_value
+
+import (
+	"fmt"
+)
+
+// Priority is an enum.
+type Priority int
+
+// List of known Priority enums.
+const (
+	Low    Priority = iota + 10
+	Urgent Priority = iota + 39
+	Medium Priority = iota + 18
+	High   Priority = iota + 27
+)
+
+func lookupPriority(e Priority) (s string, ok bool) {
+	switch e {
+	case Low:
+		return "low", true
+	case Urgent:
+		return "urgent", true
+	case Medium:
+		return "medium", true
+	case High:
+		return "high", true
+	default:
+		return "", false
+	}
+}
+
+// String implements the fmt.Stringer interface.
+func (e Priority) String() string {
+	s, ok := lookupPriority(e)
+	if !ok {
+		return fmt.Sprintf("%[3]s(%[2]d)", "", int(e), "Priority")
+	}
+	return s
+}
+
+// List of the edge Priority constants, in the value order.
+const (
+	FirstPriority = Low
+	LastPriority  = Urgent
+)
+
+var _PriorityOrder = [...]Priority{Low, Medium, High, Urgent}
+
+// positionPriority returns the position of the Priority in the order, its length if unknown.
+func positionPriority(e Priority) int {
+	switch e {
+	case Low:
+		return 0
+	case Medium:
+		return 1
+	case High:
+		return 2
+	case Urgent:
+		return 3
+	}
+	return len(_PriorityOrder)
+}
+
+// Compare returns -1 if the Priority is before o, +1 if it is after and 0 if they are equal.
+// The known constants follow the value order, the unknown values come after, sorted by value.
+func (e Priority) Compare(o Priority) int {
+	known := positionPriority(e) < len(_PriorityOrder)
+	oKnown := positionPriority(o) < len(_PriorityOrder)
+	switch {
+	case known && !oKnown, known == oKnown && e < o:
+		return -1
+	case !known && oKnown, known == oKnown && e > o:
+		return 1
+	default:
+		return 0
+	}
+}
+
+// Less returns true if the Priority is before o.
+func (e Priority) Less(o Priority) bool {
+	return e.Compare(o) < 0
+}
+
+// Next returns the constant following the Priority, and false if it is the last one or unknown.
+func (e Priority) Next() (Priority, bool) {
+	i := positionPriority(e) + 1
+	if i >= len(_PriorityOrder) {
+		return e, false
+	}
+	return _PriorityOrder[i], true
+}
+
+// Prev returns the constant preceding the Priority, and false if it is the first one or unknown.
+func (e Priority) Prev() (Priority, bool) {
+	i := positionPriority(e) - 1
+	if i < 0 || i >= len(_PriorityOrder)-1 {
+		return e, false
+	}
+	return _PriorityOrder[i], true
+}
@@ -0,0 +1,160 @@
+// Copyright (c) 2021 Hervé Gouchet. All rights reserved.
+// Use of this source code is governed by the MIT License
+// that can be found in the LICENSE file.
+
+package ordered_value_test
+
+import (
+	"sort"
+	"testing"
+
+	"github.com/matryer/is"
+
+	ordered "github.com/rvflash/genum/examples/ordered-value"
+)
+
+func TestPriority_Compare(t *testing.T) {
+	t.Parallel()
+	var (
+		are = is.New(t)
+		dt  = map[string]struct {
+			// inputs
+			e, o ordered.Priority
+			// outputs
+			out int
+		}{
+			"Equal":         {e: ordered.Medium, o: ordered.Medium},
+			"Before":        {e: ordered.Low, o: ordered.Medium, out: -1},
+			"After":         {e: ordered.Urgent, o: ordered.High, out: 1},
+			"Not CSV order": {e: ordered.Medium, o: ordered.Urgent, out: -1},
+			"Known first":   {e: ordered.Urgent, o: 0, out: -1},
+			"Unknown last":  {e: 15, o: ordered.Urgent, out: 1},
+			"Unknown":       {e: 50, o: 0, out: 1},
+			"Same unknown":  {e: 50, o: 50},
+		}
+	)
+	for name, tt := range dt {
+		tt := tt
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			are.Equal(tt.out, tt.e.Compare(tt.o))  // mismatch compare
+			are.Equal(-tt.out, tt.o.Compare(tt.e)) // mismatch reverse compare
+			are.Equal(tt.out < 0, tt.e.Less(tt.o)) // mismatch less
+		})
+	}
+}
+
+func TestPriority_Less(t *testing.T) {
+	t.Parallel()
+	are := is.New(t)
+	list := []ordered.Priority{50, ordered.Urgent, 15, ordered.Low, ordered.High, ordered.Medium}
+	sort.Slice(list, func(i, j int) bool {
+		return list[i].Less(list[j])
+	})
+	are.Equal([]ordered.Priority{ordered.Low, ordered.Medium, ordered.High, ordered.Urgent, 15, 50}, list) // mismatch order
+}
+
+func TestPriority_Next(t *testing.T) {
+	t.Parallel()
+	var (
+		are = is.New(t)
+		dt  = map[string]struct {
+			// inputs
+			in ordered.Priority
+			// outputs
+			out ordered.Priority
+			ok  bool
+		}{
+			"First":   {in: ordered.FirstPriority, out: ordered.Medium, ok: true},
+			"Sparse":  {in: ordered.High, out: ordered.Urgent, ok: true},
+			"Last":    {in: ordered.LastPriority, out: ordered.LastPriority},
+			"Unknown": {in: 15, out: 15},
+		}
+	)
+	for name, tt := range dt {
+		tt := tt
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			out, ok := tt.in.Next()
+			are.Equal(tt.out, out) // mismatch next
+			are.Equal(tt.ok, ok)   // mismatch ok
+		})
+	}
+}
+
+func TestPriority_Prev(t *testing.T) {
+	t.Parallel()
+	var (
+		are = is.New(t)
+		dt  = map[string]struct {
+			// inputs
+			in ordered.Priority
+			// outputs
+			out ordered.Priority
+			ok  bool
+		}{
+			"First":   {in: ordered.FirstPriority, out: ordered.FirstPriority},
+			"Sparse":  {in: ordered.Urgent, out: ordered.High, ok: true},
+			"Second":  {in: ordered.Medium, out: ordered.Low, ok: true},
+			"Unknown": {in: 15, out: 15},
+		}
+	)
+	for name, tt := range dt {
+		tt := tt
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			out, ok := tt.in.Prev()
+			are.Equal(tt.out, out) // mismatch prev
+			are.Equal(tt.ok, ok)   // mismatch ok
+		})
+	}
+}
+
+func TestUrgency_Less(t *testing.T) {
+	t.Parallel()
+	are := is.New(t)
+	list := []ordered.Urgency{50, ordered.UrgencyHigh, 15, ordered.UrgencyUrgent, ordered.UrgencyMedium, ordered.UrgencyLow}
+	sort.Slice(list, func(i, j int) bool {
+		return list[i].Less(list[j])
+	})
+	// The constants follow the CSV order, not their values.
+	want := []ordered.Urgency{
+		ordered.UrgencyLow, ordered.UrgencyUrgent, ordered.UrgencyMedium, ordered.UrgencyHigh, 15, 50,
+	}
+	are.Equal(want, list)                                             // mismatch order
+	are.Equal(ordered.UrgencyHigh, ordered.LastUrgency)               // mismatch last
+	are.Equal(-1, ordered.UrgencyUrgent.Compare(ordered.UrgencyHigh)) // mismatch compare
+}
+
+func TestStage_Next(t *testing.T) {
+	t.Parallel()
+	var (
+		are = is.New(t)
+		dt  = map[string]struct {
+			// inputs
+			in ordered.Stage
+			// outputs
+			next, prev ordered.Stage
+			ok         [2]bool
+		}{
+			"First":   {in: ordered.Review, next: ordered.Draft, prev: ordered.Review, ok: [2]bool{true, false}},
+			"Jump":    {in: ordered.Draft, next: ordered.Published, prev: ordered.Review, ok: [2]bool{true, true}},
+			"Last":    {in: ordered.Published, next: ordered.Published, prev: ordered.Draft, ok: [2]bool{false, true}},
+			"Skipped": {in: 1, next: 1, prev: 1},
+		}
+	)
+	for name, tt := range dt {
+		tt := tt
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			next, ok := tt.in.Next()
+			are.Equal(tt.next, next) // mismatch next
+			are.Equal(tt.ok[0], ok)  // mismatch next ok
+			prev, ok := tt.in.Prev()
+			are.Equal(tt.prev, prev) // mismatch prev
+			are.Equal(tt.ok[1], ok)  // mismatch prev ok
+		})
+	}
+	// The skipped values are unknown, so after the known ones.
+	are.Equal(1, ordered.Stage(1).Compare(ordered.Published)) // mismatch compare
+}
@@ -0,0 +1,100 @@
+// Code generated by "genum -pkg ordered_value -name Stage -type uint8 -iota -stringer -ordered -order csv stage.csv"; DO NOT EDIT.
+
+package ordered_value
+
+import (
+	"fmt"
+)
+
+// Stage is an enum.
+type Stage uint8
+
+// List of known Stage enums.
+const (
+	Review Stage = iota
+	_
+	Draft
+	_
+	_
+	Published
+)
+
+func lookupStage(e Stage) (s string, ok bool) {
+	switch e {
+	case Review:
+		return "review", true
+	case Draft:
+		return "draft", true
+	case Published:
+		return "published", true
+	default:
+		return "", false
+	}
+}
+
+// String implements the fmt.Stringer interface.
+func (e Stage) String() string {
+	s, ok := lookupStage(e)
+	if !ok {
+		return fmt.Sprintf("%[3]s(%[2]d)", "", uint8(e), "Stage")
+	}
+	return s
+}
+
+// List of the edge Stage constants, in the csv order.
+const (
+	FirstStage = Review
+	LastStage  = Published
+)
+
+var _StageOrder = [...]Stage{Review, Draft, Published}
+
+// positionStage returns the position of the Stage in the order, its length if unknown.
+func positionStage(e Stage) int {
+	switch e {
+	case Review:
+		return 0
+	case Draft:
+		return 1
+	case Published:
+		return 2
+	}
+	return len(_StageOrder)
+}
+
+// Compare returns -1 if the Stage is before o, +1 if it is after and 0 if they are equal.
+// The known constants follow the csv order, the unknown values come after, sorted by value.
+func (e Stage) Compare(o Stage) int {
+	i, j := positionStage(e), positionStage(o)
+	switch {
+	case i < j, i == j && e < o:
+		return -1
+	case i > j, i == j && e > o:
+		return 1
+	default:
+		return 0
+	}
+}
+
+// Less returns true if the Stage is before o.
+func (e Stage) Less(o Stage) bool {
+	return e.Compare(o) < 0
+}
+
+// Next returns the constant following the Stage, and false if it is the last one or unknown.
+func (e Stage) Next() (Stage, bool) {
+	i := positionStage(e) + 1
+	if i >= len(_StageOrder) {
+		return e, false
+	}
+	return _StageOrder[i], true
+}
+
+// Prev returns the constant preceding the Stage, and false if it is the first one or unknown.
+func (e Stage) Prev() (Stage, bool) {
+	i := positionStage(e) - 1
+	if i < 0 || i >= len(_StageOrder)-1 {
+		return e, false
+	}
+	return _StageOrder[i], true
+}
@@ -0,0 +1,102 @@
+// Code generated by "genum -pkg ordered_value -name Urgency -header -prefix -stringer -ordered -order csv priority.csv"; DO NOT EDIT.
+
+package ordered_value
+
+import (
+	"fmt"
+)
+
+// Urgency is an enum.
+type Urgency int
+
+// List of known Urgency enums.
+const (
+	UrgencyLow    Urgency = iota + 10
+	UrgencyUrgent Urgency = iota + 39
+	UrgencyMedium Urgency = iota + 18
+	UrgencyHigh   Urgency = iota + 27
+)
+
+func lookupUrgency(e Urgency) (s string, ok bool) {
+	switch e {
+	case UrgencyLow:
+		return "low", true
+	case UrgencyUrgent:
+		return "urgent", true
+	case UrgencyMedium:
+		return "medium", true
+	case UrgencyHigh:
+		return "high", true
+	default:
+		return "", false
+	}
+}
+
+// String implements the fmt.Stringer interface.
+func (e Urgency) String() string {
+	s, ok := lookupUrgency(e)
+	if !ok {
+		return fmt.Sprintf("%[3]s(%[2]d)", "", int(e), "Urgency")
+	}
+	return s
+}
+
+// List of the edge Urgency constants, in the csv order.
+const (
+	FirstUrgency = UrgencyLow
+	LastUrgency  = UrgencyHigh
+)
+
+var _UrgencyOrder = [...]Urgency{UrgencyLow, UrgencyUrgent, UrgencyMedium, UrgencyHigh}
+
+// positionUrgency returns the position of the Urgency in the order, its length if unknown.
+func positionUrgency(e Urgency) int {
+	switch e {
+	case UrgencyLow:
+		return 0
+	case UrgencyUrgent:
+		return 1
+	case UrgencyMedium:
+		return 2
+	case UrgencyHigh:
+		return 3
+	}
+	return len(_UrgencyOrder)
+}
+
+// Compare returns -1 if the Urgency is before o, +1 if it is after and 0 if they are equal.
+// The known constants follow the csv order, the unknown values come after, sorted by value.
+func (e Urgency) Compare(o Urgency) int {
+	i, j := positionUrgency(e), positionUrgency(o)
+	switch {
+	case i < j, i == j && e < o:
+		return -1
+	case i > j, i == j && e > o:
+		return 1
+	default:
+		return 0
+	}
+}
+
+// Less returns true if the Urgency is before o.
+func (e Urgency) Less(o Urgency) bool {
+	return e.Compare(o) < 0
+}
+
+// Next returns the constant following the Urgency, and false if it is the last one or unknown.
+func (e Urgency) Next() (Urgency, bool) {
+	i := positionUrgency(e) + 1
+	if i >= len(_UrgencyOrder) {
+		return e, false
+	}
+	return _UrgencyOrder[i], true
+}
+
+// Prev returns the constant preceding the Urgency, and false if it is the first one or unknown.
+func (e Urgency) Prev() (Urgency, bool) {
+	i := positionUrgency(e) - 1
+	if i < 0 || i >= len(_UrgencyOrder)-1 {
+		return e, false
+	}
+	return _UrgencyOrder[i], true
+}
@@ -0,0 +1,103 @@
+// Code generated by "genum -pkg register_text -name Level -header -register -stringer -stringer_format level_%[1]s level.csv"; DO NOT EDIT.
+
+package register_text
+
+import (
+	"errors"
+	"fmt"
+	"github.com/rvflash/genum/registry"
+)
+
+// Level is an enum.
+type Level int
+
+// List of known Level enums.
+const (
+	Low Level = iota + 1
+	High
+)
+
+// ErrInvalidLevel is returned, wrapped, by the decoders of Level with an invalid value.
+var ErrInvalidLevel = errors.New("invalid Level")
+
+const _LevelNames = "lowhigh"
+
+var _LevelIndexes = [...]uint8{0, 3, 7}
+
+func lookupLevel(e Level) (s string, ok bool) {
+	i := uint64(e) - 1
+	if i >= uint64(len(_LevelIndexes)-1) {
+		return "", false
+	}
+	return _LevelNames[_LevelIndexes[i]:_LevelIndexes[i+1]], true
+}
+
+// String implements the fmt.Stringer interface.
+func (e Level) String() string {
+	s, ok := lookupLevel(e)
+	if !ok {
+		return fmt.Sprintf("%[3]s(%[2]d)", "", int(e), "Level")
+	}
+	return fmt.Sprintf("level_%[1]s", s, int(e), "Level")
+}
+
+// AppendText implements the encoding.TextAppender interface.
+func (e Level) AppendText(b []byte) ([]byte, error) {
+	return append(b, e.String()...), nil
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (e Level) MarshalText() (text []byte, err error) {
+	return e.AppendText(nil)
+}
+
+const _LevelTexts = "level_highlevel_low"
+
+var _LevelTextIndexes = [...]uint8{0, 10, 19}
+
+var _LevelTextValues = [...]Level{
+	High,
+	Low,
+}
+
+func parseLevel(text []byte) (e Level, ok bool) {
+	i, j := 0, len(_LevelTextValues)
+	for i < j {
+		h := int(uint(i+j) >> 1)
+		if _LevelTexts[_LevelTextIndexes[h]:_LevelTextIndexes[h+1]] < string(text) {
+			i = h + 1
+		} else {
+			j = h
+		}
+	}
+	if i < len(_LevelTextValues) && _LevelTexts[_LevelTextIndexes[i]:_LevelTextIndexes[i+1]] == string(text) {
+		return _LevelTextValues[i], true
+	}
+	return e, false
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+func (e *Level) UnmarshalText(text []byte) error {
+	e2, ok := parseLevel(text)
+	if !ok {
+		return fmt.Errorf("%w: unknown %q", ErrInvalidLevel, text)
+	}
+	*e = e2
+	return nil
+}
+
+func init() {
+	registry.Register(
+		Level(0),
+		[]interface{}{Low, High},
+		[]string{"level_low", "level_high"},
+		func(text string) (interface{}, error) {
+			var e Level
+			err := e.UnmarshalText([]byte(text))
+			if err != nil {
+				return nil, err
+			}
+			return e, nil
+		},
+	)
+}
@@ -0,0 +1,8 @@
+// Copyright (c) 2021 Hervé Gouchet. All rights reserved.
+// Use of this source code is governed by the MIT License
+// that can be found in the LICENSE file.
+
+package register_text
+
+//go:generate genum -pkg ${GOPACKAGE} -name Status -header -register status.csv
+//go:generate genum -pkg ${GOPACKAGE} -name Level -header -register -stringer -stringer_format "level_%[1]s" level.csv
@@ -0,0 +1,56 @@
+// Copyright (c) 2021 Hervé Gouchet. All rights reserved.
+// Use of this source code is governed by the MIT License
+// that can be found in the LICENSE file.
+
+package register_text_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/matryer/is"
+
+	rt "github.com/rvflash/genum/examples/register-text"
+	"github.com/rvflash/genum/registry"
+)
+
+func TestRegister(t *testing.T) {
+	t.Parallel()
+	var (
+		are = is.New(t)
+		dt  = map[string]struct {
+			// inputs
+			name string
+			// outputs
+			names  []string
+			values []interface{}
+		}{
+			"Default": {
+				name:   "register_text.Status",
+				names:  []string{"pending", "active", "archived"},
+				values: []interface{}{rt.Pending, rt.Active, rt.Archived},
+			},
+			"Format": {
+				name:   "register_text.Level",
+				names:  []string{"level_low", "level_high"},
+				values: []interface{}{rt.Low, rt.High},
+			},
+		}
+	)
+	for name, tt := range dt {
+		tt := tt
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			e := registry.Lookup(tt.name)
+			are.True(e != nil)               // missing enum
+			are.Equal(tt.names, e.Names())   // mismatch names
+			are.Equal(tt.values, e.Values()) // mismatch values
+			for k, s := range e.Names() {
+				v, err := e.Parse(s)
+				are.NoErr(err)              // unexpected parsing error
+				are.Equal(tt.values[k], v)  // mismatch parsed value
+				are.Equal(s, fmt.Sprint(v)) // mismatch string
+			}
+		})
+	}
+}
@@ -0,0 +1,106 @@
+// Code generated by "genum -pkg register_text -name Status -header -register status.csv"; DO NOT EDIT.
+
+package register_text
+
+import (
+	"errors"
+	"fmt"
+	"github.com/rvflash/genum/registry"
+)
+
+// Status is an enum.
+type Status int
+
+// List of known Status enums.
+const (
+	Pending Status = iota + 1
+	Active
+	Archived Status = iota + 2
+)
+
+// ErrInvalidStatus is returned, wrapped, by the decoders of Status with an invalid value.
+var ErrInvalidStatus = errors.New("invalid Status")
+
+func lookupStatus(e Status) (s string, ok bool) {
+	switch e {
+	case Pending:
+		return "pending", true
+	case Active:
+		return "active", true
+	case Archived:
+		return "archived", true
+	default:
+		return "", false
+	}
+}
+
+// String implements the fmt.Stringer interface.
+func (e Status) String() string {
+	s, ok := lookupStatus(e)
+	if !ok {
+		return fmt.Sprintf("%[3]s(%[2]d)", "", int(e), "Status")
+	}
+	return s
+}
+
+// AppendText implements the encoding.TextAppender interface.
+func (e Status) AppendText(b []byte) ([]byte, error) {
+	return append(b, e.String()...), nil
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (e Status) MarshalText() (text []byte, err error) {
+	return e.AppendText(nil)
+}
+
+const _StatusTexts = "activearchivedpending"
+
+var _StatusTextIndexes = [...]uint8{0, 6, 14, 21}
+
+var _StatusTextValues = [...]Status{
+	Active,
+	Archived,
+	Pending,
+}
+
+func parseStatus(text []byte) (e Status, ok bool) {
+	i, j := 0, len(_StatusTextValues)
+	for i < j {
+		h := int(uint(i+j) >> 1)
+		if _StatusTexts[_StatusTextIndexes[h]:_StatusTextIndexes[h+1]] < string(text) {
+			i = h + 1
+		} else {
+			j = h
+		}
+	}
+	if i < len(_StatusTextValues) && _StatusTexts[_StatusTextIndexes[i]:_StatusTextIndexes[i+1]] == string(text) {
+		return _StatusTextValues[i], true
+	}
+	return e, false
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+func (e *Status) UnmarshalText(text []byte) error {
+	e2, ok := parseStatus(text)
+	if !ok {
+		return fmt.Errorf("%w: unknown %q", ErrInvalidStatus, text)
+	}
+	*e = e2
+	return nil
+}
+
+func init() {
+	registry.Register(
+		Status(0),
+		[]interface{}{Pending, Active, Archived},
+		[]string{"pending", "active", "archived"},
+		func(text string) (interface{}, error) {
+			var e Status
+			err := e.UnmarshalText([]byte(text))
+			if err != nil {
+				return nil, err
+			}
+			return e, nil
+		},
+	)
+}
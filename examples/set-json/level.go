@@ -0,0 +1,213 @@
+// Code generated by "genum -pkg set_json -name Level -type int8 -header -set -json level.csv"; DO NOT EDIT.
+
+package set_json
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Level is an enum.
+type Level int8
+
+// List of known Level enums.
+const (
+	Low    Level = iota + -3
+	Medium Level = iota + -1
+	High   Level = iota + 58
+)
+
+// ErrInvalidLevel is returned, wrapped, by the decoders of Level with an invalid value.
+var ErrInvalidLevel = errors.New("invalid Level")
+
+func lookupLevel(e Level) (s string, ok bool) {
+	switch e {
+	case Low:
+		return "low", true
+	case Medium:
+		return "medium", true
+	case High:
+		return "high", true
+	default:
+		return "", false
+	}
+}
+
+// String implements the fmt.Stringer interface.
+func (e Level) String() string {
+	s, ok := lookupLevel(e)
+	if !ok {
+		return fmt.Sprintf("%[3]s(%[2]d)", "", int8(e), "Level")
+	}
+	return s
+}
+
+// IsValid returns true if the Level is a known constant.
+func (e Level) IsValid() bool {
+	_, ok := lookupLevel(e)
+	return ok
+}
+
+// Validate returns ErrInvalidLevel if the Level is not a known constant.
+func (e Level) Validate() error {
+	if !e.IsValid() {
+		return fmt.Errorf("%w: unknown %v", ErrInvalidLevel, e)
+	}
+	return nil
+}
+
+var _LevelSetValues = [...]Level{Low, Medium, High}
+
+// LevelSet is a set of known Level constants, backed by a bitset.
+// Its zero value is an empty set ready to use.
+type LevelSet struct {
+	bits [1]uint64
+}
+
+// index returns the position of the bit representing the constant.
+func (LevelSet) index(e Level) uint64 {
+	return uint64(int64(e) - (-3))
+}
+
+// Add adds the known constants to the set, ignoring the other values.
+func (s *LevelSet) Add(values ...Level) {
+	for _, e := range values {
+		if e.IsValid() {
+			i := s.index(e)
+			s.bits[i/64] |= 1 << (i % 64)
+		}
+	}
+}
+
+// Remove removes the values from the set.
+func (s *LevelSet) Remove(values ...Level) {
+	for _, e := range values {
+		if e.IsValid() {
+			i := s.index(e)
+			s.bits[i/64] &^= 1 << (i % 64)
+		}
+	}
+}
+
+// Contains returns true if the value is in the set.
+func (s LevelSet) Contains(e Level) bool {
+	if !e.IsValid() {
+		return false
+	}
+	i := s.index(e)
+	return s.bits[i/64]&(1<<(i%64)) != 0
+}
+
+// Len returns the number of constants in the set.
+func (s LevelSet) Len() int {
+	var n int
+	for _, w := range s.bits {
+		for ; w != 0; w &= w - 1 {
+			n++
+		}
+	}
+	return n
+}
+
+// Union returns a new set with the constants in the set or in the other one.
+func (s LevelSet) Union(other LevelSet) LevelSet {
+	for k := range s.bits {
+		s.bits[k] |= other.bits[k]
+	}
+	return s
+}
+
+// Intersect returns a new set with the constants both in the set and in the other one.
+func (s LevelSet) Intersect(other LevelSet) LevelSet {
+	for k := range s.bits {
+		s.bits[k] &= other.bits[k]
+	}
+	return s
+}
+
+// NewLevelSet returns a set with the known constants among the given values.
+func NewLevelSet(values ...Level) LevelSet {
+	var s LevelSet
+	s.Add(values...)
+	return s
+}
+
+// Values returns the constants of the set, sorted by value.
+func (s LevelSet) Values() []Level {
+	res := make([]Level, 0, s.Len())
+	for _, e := range _LevelSetValues {
+		if s.Contains(e) {
+			res = append(res, e)
+		}
+	}
+	return res
+}
+
+// String implements the fmt.Stringer interface.
+func (s LevelSet) String() string {
+	var buf strings.Builder
+	_ = buf.WriteByte('[')
+	for k, e := range s.Values() {
+		if k > 0 {
+			_ = buf.WriteByte(' ')
+		}
+		_, _ = buf.WriteString(e.String())
+	}
+	_ = buf.WriteByte(']')
+	return buf.String()
+}
+
+// AppendJSON appends the JSON encoding of the Level to b.
+func (e Level) AppendJSON(b []byte) ([]byte, error) {
+	b = append(b, '"')
+	b = strconv.AppendInt(b, int64(e), 10)
+	return append(b, '"'), nil
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+func (e Level) MarshalJSON() ([]byte, error) {
+	return e.AppendJSON(make([]byte, 0, 24))
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+func (e *Level) UnmarshalJSON(data []byte) error {
+	var (
+		s   string
+		err = json.Unmarshal(data, &s)
+	)
+	if err != nil {
+		return fmt.Errorf("%w: expects int8 but got %s", ErrInvalidLevel, data)
+	}
+	v, err := strconv.ParseInt(s, 10, 8)
+	if err != nil {
+		return fmt.Errorf("%w: expects int8 but got %s", ErrInvalidLevel, s)
+	}
+	*e = Level(v)
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaler interface, the set is encoded as a list of constants.
+func (s LevelSet) MarshalJSON() ([]byte, error) {
+	return json.Marshal(s.Values())
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface, any unknown constant is rejected.
+func (s *LevelSet) UnmarshalJSON(data []byte) error {
+	var values []Level
+	err := json.Unmarshal(data, &values)
+	if err != nil {
+		return err
+	}
+	*s = LevelSet{}
+	for _, e := range values {
+		err = e.Validate()
+		if err != nil {
+			return err
+		}
+		s.Add(e)
+	}
+	return nil
+}
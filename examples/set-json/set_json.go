@@ -0,0 +1,10 @@
+// Copyright (c) 2021 Hervé Gouchet. All rights reserved.
+// Use of this source code is governed by the MIT License
+// that can be found in the LICENSE file.
+
+package set_json
+
+//go:generate genum -pkg ${GOPACKAGE} -name Status -header -set -json status.csv
+//go:generate genum -pkg ${GOPACKAGE} -name Color -type string -set -json color.csv
+//go:generate genum -pkg ${GOPACKAGE} -name Level -type int8 -header -set -json level.csv
+//go:generate genum -pkg ${GOPACKAGE} -name Size -header -set -nofmt size.csv
@@ -0,0 +1,104 @@
+// Copyright (c) 2021 Hervé Gouchet. All rights reserved.
+// Use of this source code is governed by the MIT License
+// that can be found in the LICENSE file.
+
+package set_json_test
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/matryer/is"
+
+	sj "github.com/rvflash/genum/examples/set-json"
+)
+
+func TestStatusSet(t *testing.T) {
+	t.Parallel()
+	are := is.New(t)
+	s := sj.NewStatusSet(sj.Archived, 3, sj.Pending, sj.Pending)
+	are.Equal(2, s.Len())                                       // mismatch length
+	are.True(s.Contains(sj.Pending) && !s.Contains(sj.Active))  // mismatch membership
+	are.True(!s.Contains(3))                                    // unknown value added
+	are.Equal([]sj.Status{sj.Pending, sj.Archived}, s.Values()) // mismatch values
+	are.Equal("[pending archived]", s.String())                 // mismatch string
+	u := s.Union(sj.NewStatusSet(sj.Active))
+	are.Equal(3, u.Len())                                                                   // mismatch union
+	are.Equal([]sj.Status{sj.Archived}, u.Intersect(sj.NewStatusSet(sj.Archived)).Values()) // mismatch intersect
+	u.Remove(sj.Pending, 3)
+	are.Equal([]sj.Status{sj.Active, sj.Archived}, u.Values()) // mismatch remove
+	var zero sj.StatusSet
+	are.Equal(0, zero.Len()) // mismatch zero value
+}
+
+func TestStatusSet_JSON(t *testing.T) {
+	t.Parallel()
+	var (
+		are = is.New(t)
+		dt  = map[string]struct {
+			// inputs
+			in string
+			// outputs
+			out    []sj.Status
+			failed bool
+		}{
+			"Empty":   {in: `[]`, out: []sj.Status{}},
+			"Sorted":  {in: `["4","1"]`, out: []sj.Status{sj.Pending, sj.Archived}},
+			"Unknown": {in: `["1","3"]`, failed: true},
+			"Invalid": {in: `"1"`, failed: true},
+		}
+	)
+	for name, tt := range dt {
+		tt := tt
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			var s sj.StatusSet
+			err := json.Unmarshal([]byte(tt.in), &s)
+			are.Equal(tt.failed, err != nil) // unexpected error
+			if err != nil {
+				return
+			}
+			are.Equal(tt.out, s.Values()) // mismatch values
+			b, err := json.Marshal(s)
+			are.NoErr(err) // unexpected marshal error
+			var s2 sj.StatusSet
+			are.NoErr(json.Unmarshal(b, &s2))  // unexpected round-trip error
+			are.Equal(s.Values(), s2.Values()) // mismatch round-trip
+		})
+	}
+	var s sj.StatusSet
+	are.True(errors.Is(json.Unmarshal([]byte(`["3"]`), &s), sj.ErrInvalidStatus)) // mismatch unknown error
+}
+
+func TestColorSet(t *testing.T) {
+	t.Parallel()
+	are := is.New(t)
+	s := sj.NewColorSet(sj.Red, sj.Blue, "pink")
+	are.Equal([]sj.Color{sj.Blue, sj.Red}, s.Values()) // mismatch values
+	b, err := json.Marshal(s)
+	are.NoErr(err)                         // unexpected marshal error
+	are.Equal(`["blue","red"]`, string(b)) // mismatch json
+	var s2 sj.ColorSet
+	are.True(json.Unmarshal([]byte(`["red","pink"]`), &s2) != nil) // expected unknown error
+	are.NoErr(json.Unmarshal(b, &s2))                              // unexpected round-trip error
+	are.Equal(s.Values(), s2.Values())                             // mismatch round-trip
+}
+
+func TestLevelSet(t *testing.T) {
+	t.Parallel()
+	are := is.New(t)
+	s := sj.NewLevelSet(sj.High, sj.Low, -4, 61, -128, 127)
+	are.Equal([]sj.Level{sj.Low, sj.High}, s.Values())     // mismatch values
+	are.True(s.Contains(sj.Low) && !s.Contains(sj.Medium)) // mismatch membership
+	s.Add(sj.Medium)
+	are.Equal(3, s.Len()) // mismatch length
+}
+
+func TestSizeSet(t *testing.T) {
+	t.Parallel()
+	are := is.New(t)
+	s := sj.NewSizeSet(sj.Large, 2, sj.Small)
+	are.Equal([]sj.Size{sj.Small, sj.Large}, s.Values()) // mismatch values
+	are.Equal("[small large]", s.String())               // mismatch string
+}
@@ -0,0 +1,162 @@
+// Code generated by "genum -pkg set_json -name Size -header -set -nofmt size.csv"; DO NOT EDIT.
+
+package set_json
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+)
+
+// Size is an enum.
+type Size int
+
+// List of known Size enums.
+const (
+	Small Size = iota + 1
+	Large Size = iota + 299
+)
+
+// ErrInvalidSize is returned, wrapped, by the decoders of Size with an invalid value.
+var ErrInvalidSize = errors.New("invalid Size")
+
+// invalidSizeError wraps ErrInvalidSize with the detail of the invalid value, without fmt.
+type invalidSizeError string
+
+// Error implements the error interface.
+func (e invalidSizeError) Error() string {
+	return ErrInvalidSize.Error() + ": " + string(e)
+}
+
+// Unwrap returns ErrInvalidSize.
+func (e invalidSizeError) Unwrap() error {
+	return ErrInvalidSize
+}
+
+func lookupSize(e Size) (s string, ok bool) {
+	switch e {
+	case Small:
+		return "small", true
+	case Large:
+		return "large", true
+	default:
+		return "", false
+	}
+}
+
+// String implements the fmt.Stringer interface.
+func (e Size) String() string {
+	s, ok := lookupSize(e)
+	if !ok {
+		return "Size(" + strconv.FormatInt(int64(e), 10) + ")"
+	}
+	return s
+}
+
+// IsValid returns true if the Size is a known constant.
+func (e Size) IsValid() bool {
+	_, ok := lookupSize(e)
+	return ok
+}
+
+// Validate returns ErrInvalidSize if the Size is not a known constant.
+func (e Size) Validate() error {
+	if !e.IsValid() {
+		return invalidSizeError("unknown " + strconv.FormatInt(int64(e), 10))
+	}
+	return nil
+}
+
+var _SizeSetValues = [...]Size{Small, Large}
+
+// SizeSet is a set of known Size constants.
+// Its zero value is an empty set ready to use.
+type SizeSet struct {
+	m map[Size]struct{}
+}
+
+// Add adds the known constants to the set, ignoring the other values.
+func (s *SizeSet) Add(values ...Size) {
+	for _, e := range values {
+		if !e.IsValid() {
+			continue
+		}
+		if s.m == nil {
+			s.m = make(map[Size]struct{})
+		}
+		s.m[e] = struct{}{}
+	}
+}
+
+// Remove removes the values from the set.
+func (s *SizeSet) Remove(values ...Size) {
+	for _, e := range values {
+		delete(s.m, e)
+	}
+}
+
+// Contains returns true if the value is in the set.
+func (s SizeSet) Contains(e Size) bool {
+	_, ok := s.m[e]
+	return ok
+}
+
+// Len returns the number of constants in the set.
+func (s SizeSet) Len() int {
+	return len(s.m)
+}
+
+// Union returns a new set with the constants in the set or in the other one.
+func (s SizeSet) Union(other SizeSet) SizeSet {
+	res := SizeSet{m: make(map[Size]struct{}, len(s.m)+len(other.m))}
+	for e := range s.m {
+		res.m[e] = struct{}{}
+	}
+	for e := range other.m {
+		res.m[e] = struct{}{}
+	}
+	return res
+}
+
+// Intersect returns a new set with the constants both in the set and in the other one.
+func (s SizeSet) Intersect(other SizeSet) SizeSet {
+	var res SizeSet
+	for e := range s.m {
+		if other.Contains(e) {
+			res.Add(e)
+		}
+	}
+	return res
+}
+
+// NewSizeSet returns a set with the known constants among the given values.
+func NewSizeSet(values ...Size) SizeSet {
+	var s SizeSet
+	s.Add(values...)
+	return s
+}
+
+// Values returns the constants of the set, sorted by value.
+func (s SizeSet) Values() []Size {
+	res := make([]Size, 0, s.Len())
+	for _, e := range _SizeSetValues {
+		if s.Contains(e) {
+			res = append(res, e)
+		}
+	}
+	return res
+}
+
+// String implements the fmt.Stringer interface.
+func (s SizeSet) String() string {
+	var buf strings.Builder
+	_ = buf.WriteByte('[')
+	for k, e := range s.Values() {
+		if k > 0 {
+			_ = buf.WriteByte(' ')
+		}
+		_, _ = buf.WriteString(e.String())
+	}
+	_ = buf.WriteByte(']')
+	return buf.String()
+}
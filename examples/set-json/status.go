@@ -0,0 +1,213 @@
+// Code generated by "genum -pkg set_json -name Status -header -set -json status.csv"; DO NOT EDIT.
+
+package set_json
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Status is an enum.
+type Status int
+
+// List of known Status enums.
+const (
+	Pending Status = iota + 1
+	Active
+	Archived Status = iota + 2
+)
+
+// ErrInvalidStatus is returned, wrapped, by the decoders of Status with an invalid value.
+var ErrInvalidStatus = errors.New("invalid Status")
+
+func lookupStatus(e Status) (s string, ok bool) {
+	switch e {
+	case Pending:
+		return "pending", true
+	case Active:
+		return "active", true
+	case Archived:
+		return "archived", true
+	default:
+		return "", false
+	}
+}
+
+// String implements the fmt.Stringer interface.
+func (e Status) String() string {
+	s, ok := lookupStatus(e)
+	if !ok {
+		return fmt.Sprintf("%[3]s(%[2]d)", "", int(e), "Status")
+	}
+	return s
+}
+
+// IsValid returns true if the Status is a known constant.
+func (e Status) IsValid() bool {
+	_, ok := lookupStatus(e)
+	return ok
+}
+
+// Validate returns ErrInvalidStatus if the Status is not a known constant.
+func (e Status) Validate() error {
+	if !e.IsValid() {
+		return fmt.Errorf("%w: unknown %v", ErrInvalidStatus, e)
+	}
+	return nil
+}
+
+var _StatusSetValues = [...]Status{Pending, Active, Archived}
+
+// StatusSet is a set of known Status constants, backed by a bitset.
+// Its zero value is an empty set ready to use.
+type StatusSet struct {
+	bits [1]uint64
+}
+
+// index returns the position of the bit representing the constant.
+func (StatusSet) index(e Status) uint64 {
+	return uint64(e) - 1
+}
+
+// Add adds the known constants to the set, ignoring the other values.
+func (s *StatusSet) Add(values ...Status) {
+	for _, e := range values {
+		if e.IsValid() {
+			i := s.index(e)
+			s.bits[i/64] |= 1 << (i % 64)
+		}
+	}
+}
+
+// Remove removes the values from the set.
+func (s *StatusSet) Remove(values ...Status) {
+	for _, e := range values {
+		if e.IsValid() {
+			i := s.index(e)
+			s.bits[i/64] &^= 1 << (i % 64)
+		}
+	}
+}
+
+// Contains returns true if the value is in the set.
+func (s StatusSet) Contains(e Status) bool {
+	if !e.IsValid() {
+		return false
+	}
+	i := s.index(e)
+	return s.bits[i/64]&(1<<(i%64)) != 0
+}
+
+// Len returns the number of constants in the set.
+func (s StatusSet) Len() int {
+	var n int
+	for _, w := range s.bits {
+		for ; w != 0; w &= w - 1 {
+			n++
+		}
+	}
+	return n
+}
+
+// Union returns a new set with the constants in the set or in the other one.
+func (s StatusSet) Union(other StatusSet) StatusSet {
+	for k := range s.bits {
+		s.bits[k] |= other.bits[k]
+	}
+	return s
+}
+
+// Intersect returns a new set with the constants both in the set and in the other one.
+func (s StatusSet) Intersect(other StatusSet) StatusSet {
+	for k := range s.bits {
+		s.bits[k] &= other.bits[k]
+	}
+	return s
+}
+
+// NewStatusSet returns a set with the known constants among the given values.
+func NewStatusSet(values ...Status) StatusSet {
+	var s StatusSet
+	s.Add(values...)
+	return s
+}
+
+// Values returns the constants of the set, sorted by value.
+func (s StatusSet) Values() []Status {
+	res := make([]Status, 0, s.Len())
+	for _, e := range _StatusSetValues {
+		if s.Contains(e) {
+			res = append(res, e)
+		}
+	}
+	return res
+}
+
+// String implements the fmt.Stringer interface.
+func (s StatusSet) String() string {
+	var buf strings.Builder
+	_ = buf.WriteByte('[')
+	for k, e := range s.Values() {
+		if k > 0 {
+			_ = buf.WriteByte(' ')
+		}
+		_, _ = buf.WriteString(e.String())
+	}
+	_ = buf.WriteByte(']')
+	return buf.String()
+}
+
+// AppendJSON appends the JSON encoding of the Status to b.
+func (e Status) AppendJSON(b []byte) ([]byte, error) {
+	b = append(b, '"')
+	b = strconv.AppendInt(b, int64(e), 10)
+	return append(b, '"'), nil
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+func (e Status) MarshalJSON() ([]byte, error) {
+	return e.AppendJSON(make([]byte, 0, 24))
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+func (e *Status) UnmarshalJSON(data []byte) error {
+	var (
+		s   string
+		err = json.Unmarshal(data, &s)
+	)
+	if err != nil {
+		return fmt.Errorf("%w: expects int but got %s", ErrInvalidStatus, data)
+	}
+	v, err := strconv.ParseInt(s, 10, 64)
+	if err != nil {
+		return fmt.Errorf("%w: expects int but got %s", ErrInvalidStatus, s)
+	}
+	*e = Status(v)
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaler interface, the set is encoded as a list of constants.
+func (s StatusSet) MarshalJSON() ([]byte, error) {
+	return json.Marshal(s.Values())
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface, any unknown constant is rejected.
+func (s *StatusSet) UnmarshalJSON(data []byte) error {
+	var values []Status
+	err := json.Unmarshal(data, &values)
+	if err != nil {
+		return err
+	}
+	*s = StatusSet{}
+	for _, e := range values {
+		err = e.Validate()
+		if err != nil {
+			return err
+		}
+		s.Add(e)
+	}
+	return nil
+}
@@ -0,0 +1,131 @@
+// Code generated by "genum -pkg transitions_text -name Status -header -text -transitions transitions.csv status.csv"; DO NOT EDIT.
+
+package transitions_text
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Status is an enum.
+type Status int
+
+// List of known Status enums.
+const (
+	Pending Status = iota + 1
+	Active
+	Suspended
+	Archived
+)
+
+// ErrInvalidStatus is returned, wrapped, by the decoders of Status with an invalid value.
+var ErrInvalidStatus = errors.New("invalid Status")
+
+const _StatusNames = "pendingactivesuspendedarchived"
+
+var _StatusIndexes = [...]uint8{0, 7, 13, 22, 30}
+
+func lookupStatus(e Status) (s string, ok bool) {
+	i := uint64(e) - 1
+	if i >= uint64(len(_StatusIndexes)-1) {
+		return "", false
+	}
+	return _StatusNames[_StatusIndexes[i]:_StatusIndexes[i+1]], true
+}
+
+// String implements the fmt.Stringer interface.
+func (e Status) String() string {
+	s, ok := lookupStatus(e)
+	if !ok {
+		return fmt.Sprintf("%[3]s(%[2]d)", "", int(e), "Status")
+	}
+	return s
+}
+
+// IsValid returns true if the Status is a known constant.
+func (e Status) IsValid() bool {
+	_, ok := lookupStatus(e)
+	return ok
+}
+
+// Validate returns ErrInvalidStatus if the Status is not a known constant.
+func (e Status) Validate() error {
+	if !e.IsValid() {
+		return fmt.Errorf("%w: unknown %v", ErrInvalidStatus, e)
+	}
+	return nil
+}
+
+var _StatusTransitions = map[Status][]Status{
+	Pending:   {Active, Archived},
+	Active:    {Suspended, Archived},
+	Suspended: {Active, Archived},
+}
+
+// CanTransitionTo returns true if the Status can change to the next one.
+func (e Status) CanTransitionTo(next Status) bool {
+	for _, v := range _StatusTransitions[e] {
+		if v == next {
+			return true
+		}
+	}
+	return false
+}
+
+// NextStates returns the constants to which the Status can change.
+func (e Status) NextStates() []Status {
+	return append([]Status(nil), _StatusTransitions[e]...)
+}
+
+// IsTerminal returns true if the Status is valid and can not change anymore.
+func (e Status) IsTerminal() bool {
+	return e.IsValid() && len(_StatusTransitions[e]) == 0
+}
+
+// AppendText implements the encoding.TextAppender interface.
+func (e Status) AppendText(b []byte) ([]byte, error) {
+	return append(b, e.String()...), nil
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (e Status) MarshalText() (text []byte, err error) {
+	return e.AppendText(nil)
+}
+
+const _StatusTexts = "activearchivedpendingrunningsuspended"
+
+var _StatusTextIndexes = [...]uint8{0, 6, 14, 21, 28, 37}
+
+var _StatusTextValues = [...]Status{
+	Active,
+	Archived,
+	Pending,
+	Active,
+	Suspended,
+}
+
+func parseStatus(text []byte) (e Status, ok bool) {
+	i, j := 0, len(_StatusTextValues)
+	for i < j {
+		h := int(uint(i+j) >> 1)
+		if _StatusTexts[_StatusTextIndexes[h]:_StatusTextIndexes[h+1]] < string(text) {
+			i = h + 1
+		} else {
+			j = h
+		}
+	}
+	if i < len(_StatusTextValues) && _StatusTexts[_StatusTextIndexes[i]:_StatusTextIndexes[i+1]] == string(text) {
+		return _StatusTextValues[i], true
+	}
+	return e, false
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+func (e *Status) UnmarshalText(text []byte) error {
+	e2, ok := parseStatus(text)
+	if !ok {
+		return fmt.Errorf("%w: unknown %q", ErrInvalidStatus, text)
+	}
+	*e = e2
+	return nil
+}
@@ -0,0 +1,72 @@
+// Copyright (c) 2021 Hervé Gouchet. All rights reserved.
+// Use of this source code is governed by the MIT License
+// that can be found in the LICENSE file.
+
+package transitions_text_test
+
+import (
+	"testing"
+
+	"github.com/matryer/is"
+
+	tr "github.com/rvflash/genum/examples/transitions-text"
+)
+
+func TestStatus_IsTerminal(t *testing.T) {
+	t.Parallel()
+	var (
+		are = is.New(t)
+		dt  = map[tr.Status]bool{
+			tr.Pending:   false,
+			tr.Active:    false,
+			tr.Suspended: false,
+			tr.Archived:  true,
+			0:            false,
+			5:            false,
+			-1:           false,
+		}
+	)
+	for in, out := range dt {
+		are.Equal(out, in.IsTerminal()) // mismatch terminal
+	}
+}
+
+func TestStatus_CanTransitionTo(t *testing.T) {
+	t.Parallel()
+	var (
+		are = is.New(t)
+		dt  = map[string]struct {
+			// inputs
+			from, to tr.Status
+			// outputs
+			ok bool
+		}{
+			"Allowed":  {from: tr.Pending, to: tr.Active, ok: true},
+			"Alias":    {from: tr.Suspended, to: tr.Active, ok: true},
+			"Reverse":  {from: tr.Active, to: tr.Pending},
+			"Terminal": {from: tr.Archived, to: tr.Active},
+			"Self":     {from: tr.Active, to: tr.Active},
+			"Unknown":  {from: 0, to: tr.Active},
+			"Invalid":  {from: tr.Pending, to: 5},
+		}
+	)
+	for name, tt := range dt {
+		tt := tt
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			are.Equal(tt.ok, tt.from.CanTransitionTo(tt.to)) // mismatch transition
+		})
+	}
+}
+
+func TestStatus_NextStates(t *testing.T) {
+	t.Parallel()
+	are := is.New(t)
+	// The duplicated transition from pending to active is only listed once.
+	are.Equal([]tr.Status{tr.Active, tr.Archived}, tr.Pending.NextStates()) // mismatch next states
+	are.Equal(0, len(tr.Archived.NextStates()))                             // mismatch terminal states
+	are.Equal(0, len(tr.Status(5).NextStates()))                            // mismatch unknown states
+	next := tr.Active.NextStates()
+	next[0] = tr.Pending
+	are.Equal([]tr.Status{tr.Suspended, tr.Archived}, tr.Active.NextStates()) // shared next states
+}
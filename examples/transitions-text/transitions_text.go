@@ -0,0 +1,7 @@
+// Copyright (c) 2021 Hervé Gouchet. All rights reserved.
+// Use of this source code is governed by the MIT License
+// that can be found in the LICENSE file.
+
+package transitions_text
+
+//go:generate genum -pkg ${GOPACKAGE} -name Status -header -text -transitions transitions.csv status.csv
@@ -0,0 +1,120 @@
+// Code generated by "genum -pkg values_text -name Greeting -header -values -text hello.csv"; DO NOT EDIT.
+
+package values_text
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Greeting is an enum.
+type Greeting int
+
+// List of known Greeting enums.
+const (
+	Hello Greeting = iota
+	Bonjour
+	Hola
+	Ciao Greeting = iota + 2
+)
+
+// ErrInvalidGreeting is returned, wrapped, by the decoders of Greeting with an invalid value.
+var ErrInvalidGreeting = errors.New("invalid Greeting")
+
+func lookupGreeting(e Greeting) (s string, ok bool) {
+	switch e {
+	case Hello:
+		return "hello", true
+	case Bonjour:
+		return "bonjour", true
+	case Hola:
+		return "hola", true
+	case Ciao:
+		return "ciao", true
+	default:
+		return "", false
+	}
+}
+
+// String implements the fmt.Stringer interface.
+func (e Greeting) String() string {
+	s, ok := lookupGreeting(e)
+	if !ok {
+		return fmt.Sprintf("%[3]s(%[2]d)", "", int(e), "Greeting")
+	}
+	return s
+}
+
+// IsValid returns true if the Greeting is a known constant.
+func (e Greeting) IsValid() bool {
+	_, ok := lookupGreeting(e)
+	return ok
+}
+
+// Validate returns ErrInvalidGreeting if the Greeting is not a known constant.
+func (e Greeting) Validate() error {
+	if !e.IsValid() {
+		return fmt.Errorf("%w: unknown %v", ErrInvalidGreeting, e)
+	}
+	return nil
+}
+
+// Values returns the known constants of Greeting, in their declaration order.
+func (Greeting) Values() []Greeting {
+	return []Greeting{
+		Hello,
+		Bonjour,
+		Hola,
+		Ciao,
+	}
+}
+
+// AppendText implements the encoding.TextAppender interface.
+func (e Greeting) AppendText(b []byte) ([]byte, error) {
+	return append(b, e.String()...), nil
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (e Greeting) MarshalText() (text []byte, err error) {
+	return e.AppendText(nil)
+}
+
+const _GreetingTexts = "bonjourciaohelloheyhiholasalut"
+
+var _GreetingTextIndexes = [...]uint8{0, 7, 11, 16, 19, 21, 25, 30}
+
+var _GreetingTextValues = [...]Greeting{
+	Bonjour,
+	Ciao,
+	Hello,
+	Hello,
+	Hello,
+	Hola,
+	Bonjour,
+}
+
+func parseGreeting(text []byte) (e Greeting, ok bool) {
+	i, j := 0, len(_GreetingTextValues)
+	for i < j {
+		h := int(uint(i+j) >> 1)
+		if _GreetingTexts[_GreetingTextIndexes[h]:_GreetingTextIndexes[h+1]] < string(text) {
+			i = h + 1
+		} else {
+			j = h
+		}
+	}
+	if i < len(_GreetingTextValues) && _GreetingTexts[_GreetingTextIndexes[i]:_GreetingTextIndexes[i+1]] == string(text) {
+		return _GreetingTextValues[i], true
+	}
+	return e, false
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+func (e *Greeting) UnmarshalText(text []byte) error {
+	e2, ok := parseGreeting(text)
+	if !ok {
+		return fmt.Errorf("%w: unknown %q", ErrInvalidGreeting, text)
+	}
+	*e = e2
+	return nil
+}
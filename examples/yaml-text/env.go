@@ -0,0 +1,106 @@
+// Code generated by "genum -pkg yaml_text -name Env -header -yaml env.csv"; DO NOT EDIT.
+
+package yaml_text
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Env is an enum.
+type Env int
+
+// List of known Env enums.
+const (
+	Dev Env = iota + 1
+	Staging
+	Prod
+)
+
+// ErrInvalidEnv is returned, wrapped, by the decoders of Env with an invalid value.
+var ErrInvalidEnv = errors.New("invalid Env")
+
+const _EnvNames = "devstagingprod"
+
+var _EnvIndexes = [...]uint8{0, 3, 10, 14}
+
+func lookupEnv(e Env) (s string, ok bool) {
+	i := uint64(e) - 1
+	if i >= uint64(len(_EnvIndexes)-1) {
+		return "", false
+	}
+	return _EnvNames[_EnvIndexes[i]:_EnvIndexes[i+1]], true
+}
+
+// String implements the fmt.Stringer interface.
+func (e Env) String() string {
+	s, ok := lookupEnv(e)
+	if !ok {
+		return fmt.Sprintf("%[3]s(%[2]d)", "", int(e), "Env")
+	}
+	return s
+}
+
+// AppendText implements the encoding.TextAppender interface.
+func (e Env) AppendText(b []byte) ([]byte, error) {
+	return append(b, e.String()...), nil
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (e Env) MarshalText() (text []byte, err error) {
+	return e.AppendText(nil)
+}
+
+const _EnvTexts = "devprodstaging"
+
+var _EnvTextIndexes = [...]uint8{0, 3, 7, 14}
+
+var _EnvTextValues = [...]Env{
+	Dev,
+	Prod,
+	Staging,
+}
+
+func parseEnv(text []byte) (e Env, ok bool) {
+	i, j := 0, len(_EnvTextValues)
+	for i < j {
+		h := int(uint(i+j) >> 1)
+		if _EnvTexts[_EnvTextIndexes[h]:_EnvTextIndexes[h+1]] < string(text) {
+			i = h + 1
+		} else {
+			j = h
+		}
+	}
+	if i < len(_EnvTextValues) && _EnvTexts[_EnvTextIndexes[i]:_EnvTextIndexes[i+1]] == string(text) {
+		return _EnvTextValues[i], true
+	}
+	return e, false
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+func (e *Env) UnmarshalText(text []byte) error {
+	e2, ok := parseEnv(text)
+	if !ok {
+		return fmt.Errorf("%w: unknown %q", ErrInvalidEnv, text)
+	}
+	*e = e2
+	return nil
+}
+
+// MarshalYAML implements the yaml.Marshaler interface.
+func (e Env) MarshalYAML() (interface{}, error) {
+	if _, ok := lookupEnv(e); !ok {
+		return nil, fmt.Errorf("%w: unknown %v", ErrInvalidEnv, e)
+	}
+	return e.String(), nil
+}
+
+// UnmarshalYAML implements the yaml.Unmarshaler interface.
+func (e *Env) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s string
+	err := unmarshal(&s)
+	if err != nil {
+		return fmt.Errorf("%w: expects a scalar: %v", ErrInvalidEnv, err)
+	}
+	return e.UnmarshalText([]byte(s))
+}
@@ -0,0 +1,117 @@
+// Code generated by "genum -pkg yaml_text -name Tier -header -yaml -nofmt tier.csv"; DO NOT EDIT.
+
+package yaml_text
+
+import (
+	"errors"
+	"strconv"
+)
+
+// Tier is an enum.
+type Tier int
+
+// List of known Tier enums.
+const (
+	Free Tier = iota + 1
+	Pro
+)
+
+// ErrInvalidTier is returned, wrapped, by the decoders of Tier with an invalid value.
+var ErrInvalidTier = errors.New("invalid Tier")
+
+// invalidTierError wraps ErrInvalidTier with the detail of the invalid value, without fmt.
+type invalidTierError string
+
+// Error implements the error interface.
+func (e invalidTierError) Error() string {
+	return ErrInvalidTier.Error() + ": " + string(e)
+}
+
+// Unwrap returns ErrInvalidTier.
+func (e invalidTierError) Unwrap() error {
+	return ErrInvalidTier
+}
+
+const _TierNames = "freepro"
+
+var _TierIndexes = [...]uint8{0, 4, 7}
+
+func lookupTier(e Tier) (s string, ok bool) {
+	i := uint64(e) - 1
+	if i >= uint64(len(_TierIndexes)-1) {
+		return "", false
+	}
+	return _TierNames[_TierIndexes[i]:_TierIndexes[i+1]], true
+}
+
+// String implements the fmt.Stringer interface.
+func (e Tier) String() string {
+	s, ok := lookupTier(e)
+	if !ok {
+		return "Tier(" + strconv.FormatInt(int64(e), 10) + ")"
+	}
+	return s
+}
+
+// AppendText implements the encoding.TextAppender interface.
+func (e Tier) AppendText(b []byte) ([]byte, error) {
+	return append(b, e.String()...), nil
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (e Tier) MarshalText() (text []byte, err error) {
+	return e.AppendText(nil)
+}
+
+const _TierTexts = "freepro"
+
+var _TierTextIndexes = [...]uint8{0, 4, 7}
+
+var _TierTextValues = [...]Tier{
+	Free,
+	Pro,
+}
+
+func parseTier(text []byte) (e Tier, ok bool) {
+	i, j := 0, len(_TierTextValues)
+	for i < j {
+		h := int(uint(i+j) >> 1)
+		if _TierTexts[_TierTextIndexes[h]:_TierTextIndexes[h+1]] < string(text) {
+			i = h + 1
+		} else {
+			j = h
+		}
+	}
+	if i < len(_TierTextValues) && _TierTexts[_TierTextIndexes[i]:_TierTextIndexes[i+1]] == string(text) {
+		return _TierTextValues[i], true
+	}
+	return e, false
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+func (e *Tier) UnmarshalText(text []byte) error {
+	e2, ok := parseTier(text)
+	if !ok {
+		return invalidTierError("unknown " + strconv.Quote(string(text)))
+	}
+	*e = e2
+	return nil
+}
+
+// MarshalYAML implements the yaml.Marshaler interface.
+func (e Tier) MarshalYAML() (interface{}, error) {
+	if _, ok := lookupTier(e); !ok {
+		return nil, invalidTierError("unknown " + strconv.FormatInt(int64(e), 10))
+	}
+	return e.String(), nil
+}
+
+// UnmarshalYAML implements the yaml.Unmarshaler interface.
+func (e *Tier) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s string
+	err := unmarshal(&s)
+	if err != nil {
+		return invalidTierError("expects a scalar: " + err.Error())
+	}
+	return e.UnmarshalText([]byte(s))
+}
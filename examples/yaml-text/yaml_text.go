@@ -0,0 +1,9 @@
+// Copyright (c) 2021 Hervé Gouchet. All rights reserved.
+// Use of this source code is governed by the MIT License
+// that can be found in the LICENSE file.
+
+package yaml_text
+
+//go:generate genum -pkg ${GOPACKAGE} -name Env -header -yaml env.csv
+//go:generate genum -pkg ${GOPACKAGE} -name Zone -header -yaml_node zone.csv
+//go:generate genum -pkg ${GOPACKAGE} -name Tier -header -yaml -nofmt tier.csv
@@ -0,0 +1,111 @@
+// Copyright (c) 2021 Hervé Gouchet. All rights reserved.
+// Use of this source code is governed by the MIT License
+// that can be found in the LICENSE file.
+
+package yaml_text_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/matryer/is"
+	yamlv2 "gopkg.in/yaml.v2"
+	yamlv3 "gopkg.in/yaml.v3"
+
+	yt "github.com/rvflash/genum/examples/yaml-text"
+)
+
+type config struct {
+	Env  yt.Env  `yaml:"env"`
+	Zone yt.Zone `yaml:"zone"`
+	Tier yt.Tier `yaml:"tier"`
+}
+
+func TestConfig_YAMLv2(t *testing.T) {
+	t.Parallel()
+	var (
+		are = is.New(t)
+		dt  = map[string]struct {
+			// inputs
+			in string
+			// outputs
+			out config
+			err error
+		}{
+			"Default":    {in: "env: staging\ntier: pro\n", out: config{Env: yt.Staging, Tier: yt.Pro}},
+			"Unknown":    {in: "env: qa\n", err: yt.ErrInvalidEnv},
+			"Not scalar": {in: "env: [dev]\n", err: yt.ErrInvalidEnv},
+			"Case":       {in: "env: PROD\n", err: yt.ErrInvalidEnv},
+			"No format":  {in: "tier: free\n", out: config{Tier: yt.Free}},
+		}
+	)
+	for name, tt := range dt {
+		tt := tt
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			var out config
+			err := yamlv2.Unmarshal([]byte(tt.in), &out)
+			are.True(errors.Is(err, tt.err)) // mismatch error
+			are.Equal(tt.out, out)           // mismatch config
+		})
+	}
+}
+
+func TestConfig_YAMLv3(t *testing.T) {
+	t.Parallel()
+	var (
+		are = is.New(t)
+		dt  = map[string]struct {
+			// inputs
+			in string
+			// outputs
+			out  config
+			err  error
+			line string
+		}{
+			"Default":    {in: "env: dev\nzone: asia\n", out: config{Env: yt.Dev, Zone: yt.Asia}},
+			"Unknown":    {in: "env: dev\nzone: africa\n", err: yt.ErrInvalidZone, line: "line 2"},
+			"Not scalar": {in: "zone:\n  - asia\n", err: yt.ErrInvalidZone, line: "line 2"},
+			"Unknown v2": {in: "tier: gold\n", err: yt.ErrInvalidTier},
+		}
+	)
+	for name, tt := range dt {
+		tt := tt
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			var out config
+			err := yamlv3.Unmarshal([]byte(tt.in), &out)
+			are.True(errors.Is(err, tt.err)) // mismatch error
+			if tt.line != "" {
+				are.True(strings.Contains(err.Error(), tt.line)) // mismatch line
+			}
+			if tt.err == nil {
+				are.Equal(tt.out, out) // mismatch config
+			}
+		})
+	}
+}
+
+func TestConfig_RoundTrip(t *testing.T) {
+	t.Parallel()
+	var (
+		are = is.New(t)
+		in  = config{Env: yt.Prod, Zone: yt.Europe, Tier: yt.Free}
+	)
+	b, err := yamlv3.Marshal(in)
+	are.NoErr(err)                                                // unexpected v3 error
+	are.Equal("env: prod\nzone: europe\ntier: free\n", string(b)) // mismatch v3 data
+	var out config
+	are.NoErr(yamlv3.Unmarshal(b, &out)) // unexpected v3 decoding error
+	are.Equal(in, out)                   // mismatch v3 round trip
+
+	b, err = yamlv2.Marshal(in)
+	are.NoErr(err) // unexpected v2 error
+	out = config{}
+	are.NoErr(yamlv2.Unmarshal(b, &out)) // unexpected v2 decoding error
+	are.Equal(in, out)                   // mismatch v2 round trip
+
+	_, err = yamlv3.Marshal(config{Env: 9})
+	are.True(errors.Is(err, yt.ErrInvalidEnv)) // expected invalid error
+}
@@ -0,0 +1,107 @@
+// Code generated by "genum -pkg yaml_text -name Zone -header -yaml_node zone.csv"; DO NOT EDIT.
+
+package yaml_text
+
+import (
+	"errors"
+	"fmt"
+	"gopkg.in/yaml.v3"
+)
+
+// Zone is an enum.
+type Zone int
+
+// List of known Zone enums.
+const (
+	Europe Zone = iota + 1
+	Asia
+)
+
+// ErrInvalidZone is returned, wrapped, by the decoders of Zone with an invalid value.
+var ErrInvalidZone = errors.New("invalid Zone")
+
+const _ZoneNames = "europeasia"
+
+var _ZoneIndexes = [...]uint8{0, 6, 10}
+
+func lookupZone(e Zone) (s string, ok bool) {
+	i := uint64(e) - 1
+	if i >= uint64(len(_ZoneIndexes)-1) {
+		return "", false
+	}
+	return _ZoneNames[_ZoneIndexes[i]:_ZoneIndexes[i+1]], true
+}
+
+// String implements the fmt.Stringer interface.
+func (e Zone) String() string {
+	s, ok := lookupZone(e)
+	if !ok {
+		return fmt.Sprintf("%[3]s(%[2]d)", "", int(e), "Zone")
+	}
+	return s
+}
+
+// AppendText implements the encoding.TextAppender interface.
+func (e Zone) AppendText(b []byte) ([]byte, error) {
+	return append(b, e.String()...), nil
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (e Zone) MarshalText() (text []byte, err error) {
+	return e.AppendText(nil)
+}
+
+const _ZoneTexts = "asiaeurope"
+
+var _ZoneTextIndexes = [...]uint8{0, 4, 10}
+
+var _ZoneTextValues = [...]Zone{
+	Asia,
+	Europe,
+}
+
+func parseZone(text []byte) (e Zone, ok bool) {
+	i, j := 0, len(_ZoneTextValues)
+	for i < j {
+		h := int(uint(i+j) >> 1)
+		if _ZoneTexts[_ZoneTextIndexes[h]:_ZoneTextIndexes[h+1]] < string(text) {
+			i = h + 1
+		} else {
+			j = h
+		}
+	}
+	if i < len(_ZoneTextValues) && _ZoneTexts[_ZoneTextIndexes[i]:_ZoneTextIndexes[i+1]] == string(text) {
+		return _ZoneTextValues[i], true
+	}
+	return e, false
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+func (e *Zone) UnmarshalText(text []byte) error {
+	e2, ok := parseZone(text)
+	if !ok {
+		return fmt.Errorf("%w: unknown %q", ErrInvalidZone, text)
+	}
+	*e = e2
+	return nil
+}
+
+// MarshalYAML implements the yaml.Marshaler interface.
+func (e Zone) MarshalYAML() (interface{}, error) {
+	if _, ok := lookupZone(e); !ok {
+		return nil, fmt.Errorf("%w: unknown %v", ErrInvalidZone, e)
+	}
+	return e.String(), nil
+}
+
+// UnmarshalYAML implements the yaml.Unmarshaler interface.
+func (e *Zone) UnmarshalYAML(value *yaml.Node) error {
+	if value.Kind != yaml.ScalarNode {
+		return fmt.Errorf("line %d: %w: expects a scalar but got %s", value.Line, ErrInvalidZone, value.Tag)
+	}
+	err := e.UnmarshalText([]byte(value.Value))
+	if err != nil {
+		return fmt.Errorf("line %d: %w", value.Line, err)
+	}
+	return nil
+}
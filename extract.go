@@ -0,0 +1,181 @@
+// Copyright (c) 2021 Hervé Gouchet. All rights reserved.
+// Use of this source code is governed by the MIT License
+// that can be found in the LICENSE file.
+
+package main
+
+import (
+	"encoding/csv"
+	"fmt"
+	"go/ast"
+	"go/constant"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/rvflash/genum/pkg/genum"
+)
+
+const deprecatedPrefix = "Deprecated:"
+
+func runExtract(e env, args []string) int {
+	var (
+		fs   = newFlagSet(e, "extract", extractSynopsis, extractSummary)
+		name = fs.String("name", genum.DefaultType, "type name of the constants to extract")
+	)
+	if code, ok := parseFlags(fs, args); !ok {
+		return code
+	}
+	paths := fs.Args()
+	if len(paths) == 0 {
+		paths = []string{"."}
+	}
+	records, err := extract(paths, *name)
+	if err != nil {
+		return e.fail("extract: %s", err)
+	}
+	w := csv.NewWriter(e.stdout)
+	err = w.WriteAll(records)
+	if err != nil {
+		return e.fail("extract: %s", err)
+	}
+	return exitOK
+}
+
+// extract returns the CSV records, header included, of the constants of the type declared in the Go files
+// or in the directories. The values are evaluated by type-checking the files, without their imports.
+func extract(paths []string, typeName string) ([][]string, error) {
+	fset := token.NewFileSet()
+	files, err := parseGoFiles(fset, paths)
+	if err != nil {
+		return nil, err
+	}
+	var (
+		conf = types.Config{Importer: noImporter{}, Error: func(error) {}}
+		info = &types.Info{Defs: make(map[*ast.Ident]types.Object)}
+	)
+	// Errors are ignored: the constants can be evaluated without the imported packages.
+	_, _ = conf.Check("", fset, files, info)
+
+	var (
+		records    [][]string
+		deprecated bool
+	)
+	for _, f := range files {
+		for _, d := range f.Decls {
+			decl, ok := d.(*ast.GenDecl)
+			if !ok || decl.Tok != token.CONST {
+				continue
+			}
+			for _, spec := range decl.Specs {
+				vs := spec.(*ast.ValueSpec)
+				doc := vs.Doc
+				if doc == nil && len(decl.Specs) == 1 {
+					doc = decl.Doc
+				}
+				msg := deprecation(doc)
+				for _, id := range vs.Names {
+					c, ok := info.Defs[id].(*types.Const)
+					if !ok || id.Name == "_" || !isNamed(c.Type(), typeName) {
+						continue
+					}
+					deprecated = deprecated || msg != ""
+					records = append(records, []string{id.Name, constValue(c.Val()), msg})
+				}
+			}
+		}
+	}
+	if len(records) == 0 {
+		return nil, fmt.Errorf("constants of %s: %w", typeName, genum.ErrMissing)
+	}
+	header := []string{"name", "value", "deprecated"}
+	if !deprecated {
+		header = header[:2]
+		for k := range records {
+			records[k] = records[k][:2]
+		}
+	}
+	return append([][]string{header}, records...), nil
+}
+
+// parseGoFiles parses the Go files, the test ones excluded for the directories.
+func parseGoFiles(fset *token.FileSet, paths []string) ([]*ast.File, error) {
+	var names []string
+	for _, path := range paths {
+		fi, err := os.Stat(path)
+		if err != nil {
+			return nil, err
+		}
+		if !fi.IsDir() {
+			names = append(names, path)
+			continue
+		}
+		matches, err := filepath.Glob(filepath.Join(path, "*.go"))
+		if err != nil {
+			return nil, err
+		}
+		for _, name := range matches {
+			if !strings.HasSuffix(name, "_test.go") {
+				names = append(names, name)
+			}
+		}
+	}
+	files := make([]*ast.File, 0, len(names))
+	for _, name := range names {
+		f, err := parser.ParseFile(fset, name, nil, parser.ParseComments)
+		if err != nil {
+			return nil, err
+		}
+		files = append(files, f)
+	}
+	return files, nil
+}
+
+// deprecation returns the deprecation message of the comment, or "true" without message.
+func deprecation(doc *ast.CommentGroup) string {
+	if doc == nil {
+		return ""
+	}
+	for _, p := range strings.Split(doc.Text(), "\n\n") {
+		if !strings.HasPrefix(p, deprecatedPrefix) {
+			continue
+		}
+		msg := strings.Join(strings.Fields(strings.TrimPrefix(p, deprecatedPrefix)), " ")
+		if msg == "" {
+			return "true"
+		}
+		return msg
+	}
+	return ""
+}
+
+// isNamed returns true if the type is declared with this name.
+func isNamed(t types.Type, name string) bool {
+	n, ok := t.(*types.Named)
+	return ok && n.Obj().Name() == name
+}
+
+// constValue returns the value of the constant as expected in the CSV data.
+func constValue(v constant.Value) string {
+	switch v.Kind() {
+	case constant.String:
+		return constant.StringVal(v)
+	case constant.Float:
+		f, _ := constant.Float64Val(v)
+		return strconv.FormatFloat(f, 'g', -1, 64)
+	default:
+		return v.ExactString()
+	}
+}
+
+// noImporter does not import any package.
+type noImporter struct{}
+
+// Import implements the types.Importer interface.
+func (noImporter) Import(path string) (*types.Package, error) {
+	return nil, fmt.Errorf("import %q: %w", path, genum.ErrMissing)
+}
@@ -1,9 +1,11 @@
 module github.com/rvflash/genum
 
-go 1.16
+go 1.18
 
 require (
-	github.com/google/go-cmp v0.5.6 // indirect
-	github.com/matryer/is v1.4.0 // indirect
+	github.com/google/go-cmp v0.5.6
+	github.com/matryer/is v1.4.0
 	github.com/rvflash/naming v1.0.2
+	gopkg.in/yaml.v2 v2.4.0
+	gopkg.in/yaml.v3 v3.0.1
 )
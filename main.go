@@ -5,67 +5,361 @@
 package main
 
 import (
+	"errors"
 	"flag"
 	"fmt"
-	"log"
+	"io"
 	"os"
+	"path/filepath"
 
 	"github.com/rvflash/genum/pkg/genum"
+	"github.com/rvflash/naming"
 )
 
 const (
 	bitmaskUsage = `use one integer to hold multiple flags, provide bitwise operations and 
 overwrite the enum base type with unsigned integer type (size in bits based on the number of values)`
-	commentUsage        = "add in comment the values of generated constants"
-	enumTypeUsage       = "enum type name"
-	enumKindUsage       = "enum base type"
-	iotaUsage           = "declare sequentially growing numeric constants"
-	jsonUsage           = "implement the json.Marshaler and json.Unmarshaler interfaces"
+	binaryUsage     = "implement the encoding.BinaryMarshaler, encoding.BinaryUnmarshaler and gob interfaces (big-endian, int and uint on 8 bytes)"
+	closedUsage     = "reject any unknown value in the decoders (exclusive with -open and -unknown)"
+	commentUsage    = "add in comment the values of generated constants"
+	deprecatedUsage = `behavior of the parsers with the deprecated values:
+[accept] parses them as any other values
+[warn] parses them but calls the Deprecated<T>Handler function, logging them by default
+[reject] returns an error`
+	enumTypeUsage      = "enum type name"
+	enumKindUsage      = "enum base type"
+	flagUsage          = "implement the flag.Value and pflag.Value interfaces (implies the text marshaling)"
+	graphQLUsage       = "implement the graphql.Marshaler and graphql.Unmarshaler interfaces (gqlgen)"
+	graphQLSchemaUsage = "output file name of the GraphQL schema declaring the enum type"
+	headerUsage        = `use the first line of the CSV to name the columns:
+[name] the constant name
+[value] the constant value
+[deprecated] any message or boolean to deprecate the constant
+[retired] boolean to reserve the constant value, without naming it
+[aliases] other spellings of the constant name accepted by the parsers, separated by a pipe
+[label:<lang>] label of the constant in this language, the first one being the default
+[<name>:<type>] metadata of the constant returned by a method, typed as bool, string or numeric
+[group] group of the constant, inherited by the next records with an empty cell`
+	iotaUsage   = "declare sequentially growing numeric constants"
+	jsonUsage   = "implement the json.Marshaler and json.Unmarshaler interfaces"
+	lookupUsage = `lookup of the constant names:
+[auto] the most suitable one based on the values
+[array] one array indexed by the integer values
+[runs] one array by run of consecutive integer values
+[switch] a switch statement
+[map] a map indexed by the values`
+	noFmtUsage          = "generate code without the fmt package, using strconv and string concatenation (TinyGo)"
 	noPrefixUsage       = "trim the type name from the generated constant names"
-	outputUsage         = "output file name; default dst_dir/<snake_type>.go"
-	packageNameUsage    = "package name"
+	openUsage           = "accept any unknown value in the decoders, kept as is or decoded as the -unknown constant"
+	outputUsage         = "output file name, or its directory created as needed (default ./<snake_type>.go)"
+	packageNameUsage    = "package name (default the package declared in the output directory, or its base name)"
 	prefixUsage         = "add the type name as prefix of each generated constant names"
+	transitionsUsage    = "CSV file of the allowed transitions between constants, with from,to records (implies -validator)"
+	orderedUsage        = "generate the Compare, Less, Next and Prev methods, with the First<Type> and Last<Type> constants"
+	orderUsage          = "order of the ordering methods: csv or value"
+	setUsage            = "generate the <Type>Set type holding constants with membership operations (implies -stringer and -validator)"
+	registerUsage       = "register the enum type to list and parse it at runtime (implies the text marshaling)"
 	stringerUsage       = "implement the fmt.Stringer interface"
 	stringFormaterUsage = `format used as returned value by the fmt.Stringer method:
 [%d] represents the enum name
 [%d] represents the enum value
 [%d] represents the enum type`
+	switchMaxUsage = "maximum number of constants looked up with a switch statement by the auto lookup, 0 disables it"
+	testsUsage     = "generate the <snake_type>_test.go file with round-trip, validity, bitmask and fuzz tests"
 	textUsage      = "implement the encoding.TextMarshaler and encoding.TextUnmarshaler interfaces"
+	unknownUsage   = "name of the constant used by the decoders as unknown value (implies -open)"
 	validatorUsage = `add a method "IsValid" to verify the set up of the constant`
+	valuesUsage    = `add a method "Values" listing the constants (implies -stringer and -validator)`
 	xmlUsage       = "implement the xml.Marshaler and xml.Unmarshaler interfaces"
+	yamlUsage      = "implement the yaml.Marshaler and yaml.Unmarshaler interfaces (yaml.v2 and yaml.v3)"
+	yamlNodeUsage  = "implement the yaml.Unmarshaler interface with the yaml.v3 node to report lines on error"
 )
 
-const cmdFileName = 1
+// Exit codes of the command line.
+const (
+	// exitOK is returned when the command succeeded or its help was requested.
+	exitOK = 0
+	// exitFailure is returned when the command failed: source not readable, invalid data or file not written.
+	exitFailure = 1
+	// exitUsage is returned when the command line is invalid: unknown flag, unsupported value or contradictory flags.
+	exitUsage = 2
+)
 
 func main() {
+	os.Exit(Run(os.Args[1:], os.Stdin, os.Stdout, os.Stderr))
+}
+
+// Run runs the command line with these arguments, given without the program name, and returns its exit code.
+// Without any command as first argument, it runs the gen command, as the first versions of genum.
+func Run(args []string, stdin io.Reader, stdout, stderr io.Writer) int {
+	e := env{args: args, stdin: stdin, stdout: stdout, stderr: stderr}
+	if len(args) > 0 {
+		switch args[0] {
+		case "help":
+			return runHelp(e, args[1:])
+		case "-h", "-help", "--help":
+			// Without command, the help flag lists the commands rather than the flags of gen.
+			return runHelp(e, nil)
+		}
+		for _, c := range commands() {
+			if c.name == args[0] {
+				return c.run(e, args[1:])
+			}
+		}
+	}
+	return runGen(e, args)
+}
+
+// env is the environment of a command.
+type env struct {
+	args   []string
+	stdin  io.Reader
+	stdout io.Writer
+	stderr io.Writer
+}
+
+// fail reports the error on the standard error and returns the exit code of a failed command.
+func (e env) fail(format string, a ...interface{}) int {
+	_, _ = fmt.Fprintf(e.stderr, genum.Command+": "+format+"\n", a...)
+	return exitFailure
+}
+
+// command is a sub-command of genum.
+type command struct {
+	name     string
+	synopsis string
+	summary  string
+	run      func(e env, args []string) int
+}
+
+func commands() []command {
+	return []command{
+		{name: "gen", synopsis: genSynopsis, summary: genSummary, run: runGen},
+		{name: "check", synopsis: genSynopsis, summary: checkSummary, run: runCheck},
+		{name: "list", synopsis: genSynopsis, summary: listSummary, run: runList},
+		{name: "init", synopsis: initSynopsis, summary: initSummary, run: runInit},
+		{name: "extract", synopsis: extractSynopsis, summary: extractSummary, run: runExtract},
+	}
+}
+
+const (
+	genSynopsis     = "[flags] [file]"
+	genSummary      = "generate the enum based on the CSV data of the file or of the standard input"
+	checkSummary    = "check the CSV data and the code generated with the same flags as gen, without writing any file"
+	listSummary     = "list the constants parsed from the CSV data with the same flags as gen"
+	initSynopsis    = "[flags] [file]"
+	initSummary     = "create the CSV file of a new enum, with its header, and print its go:generate directive"
+	extractSynopsis = "[flags] [file.go|dir...]"
+	extractSummary  = "extract as CSV data the constants of an existing Go type, to migrate it on genum"
+)
+
+// newFlagSet returns the flag set of the command, printing its usage on the standard error.
+func newFlagSet(e env, name, synopsis, summary string) *flag.FlagSet {
+	fs := flag.NewFlagSet(name, flag.ContinueOnError)
+	fs.SetOutput(e.stderr)
+	fs.Usage = func() {
+		_, _ = fmt.Fprintf(fs.Output(), "usage: %s %s %s\n\n%s.\n\nflags:\n", genum.Command, name, synopsis, summary)
+		fs.PrintDefaults()
+	}
+	return fs
+}
+
+// parseFlags parses the arguments of the command. On failure or with the help flag, it returns false
+// and the exit code of the command.
+func parseFlags(fs *flag.FlagSet, args []string) (int, bool) {
+	err := fs.Parse(args)
+	switch {
+	case errors.Is(err, flag.ErrHelp):
+		return exitOK, false
+	case err != nil:
+		return exitUsage, false
+	default:
+		return exitOK, true
+	}
+}
+
+func runHelp(e env, args []string) int {
+	if len(args) > 0 {
+		for _, c := range commands() {
+			if c.name == args[0] {
+				e.stderr = e.stdout
+				return c.run(e, []string{"-h"})
+			}
+		}
+		return e.fail("help: unknown command %q", args[0])
+	}
+	_, _ = fmt.Fprintf(e.stdout, "usage: %s <command> [flags] [args]\n\ncommands:\n", genum.Command)
+	for _, c := range commands() {
+		_, _ = fmt.Fprintf(e.stdout, "  %-8s %s\n", c.name, c.summary)
+	}
+	_, _ = fmt.Fprintf(e.stdout, "\nWithout command, %[1]s runs gen. Use \"%[1]s help <command>\" for its flags.\n", genum.Command)
+	return exitOK
+}
+
+// parseSettings parses the flags of the gen command and opens its source.
+func parseSettings(e env, name, summary string, args []string) (*Settings, int, bool) {
 	var (
-		s = new(Settings)
-		w = log.New(os.Stderr, genum.Command+": ", 0)
-		u = fmt.Sprintf(stringFormaterUsage, genum.NamePos, genum.ValuePos, genum.TypePos)
+		s  = new(Settings)
+		fs = newFlagSet(e, name, genSynopsis, summary)
 	)
-	flag.StringVar(&s.packageName, "pkg", "", packageNameUsage)
-	flag.StringVar(&s.enumType, "name", genum.DefaultType, enumTypeUsage)
-	flag.StringVar(&s.enumKind, "type", genum.DefaultKind, enumKindUsage)
-	flag.StringVar(&s.dstDir, "output", "", outputUsage)
-	flag.StringVar(&s.stringFormater, "stringer_format", genum.NameFormat(), u)
-	flag.BoolVar(&s.stringer, "stringer", false, stringerUsage)
-	flag.BoolVar(&s.trimPrefix, "noprefix", false, noPrefixUsage)
-	flag.BoolVar(&s.joinPrefix, "prefix", false, prefixUsage)
-	flag.BoolVar(&s.jsonMarshaler, "json", false, jsonUsage)
-	flag.BoolVar(&s.textMarshaler, "text", false, textUsage)
-	flag.BoolVar(&s.xmlMarshaler, "xml", false, xmlUsage)
-	flag.BoolVar(&s.validator, "validator", false, validatorUsage)
-	flag.BoolVar(&s.comment, "comment", false, commentUsage)
-	flag.BoolVar(&s.bitmask, "bitmask", false, bitmaskUsage)
-	flag.BoolVar(&s.iota, "iota", true, iotaUsage)
-	flag.Parse()
-
-	err := s.ReadFrom(flag.Args(), os.Stdin)
+	s.flags(fs)
+	if code, ok := parseFlags(fs, args); !ok {
+		return nil, code, false
+	}
+	s.explicit = make(map[string]bool)
+	fs.Visit(func(f *flag.Flag) {
+		s.explicit[f.Name] = true
+	})
+	err := s.Validate()
+	if err != nil {
+		_ = e.fail("%s", err)
+		return nil, exitUsage, false
+	}
+	err = s.ReadFrom(fs.Args(), e.stdin)
 	if err != nil {
-		w.Fatalf("source: %s", err)
+		return nil, e.fail("source: %s", err), false
+	}
+	return s, exitOK, true
+}
+
+func runGen(e env, args []string) int {
+	s, code, ok := parseSettings(e, "gen", genSummary, args)
+	if !ok {
+		return code
+	}
+	if s.packageName == "" {
+		var err error
+		s.packageName, err = packageName(filepath.Dir(s.DstFilename()))
+		if err != nil {
+			return e.fail("package: %s", err)
+		}
 	}
-	err = genum.Generate(genum.Layout(s, os.Args[cmdFileName:])...)
+	err := genum.Generate(genum.Layout(s, e.args)...)
 	if err != nil {
-		w.Fatal(err)
+		return e.fail("%s", err)
 	}
+	return exitOK
+}
+
+// packageName returns the name of the package declared in the directory dir or, without Go file, its base name.
+func packageName(dir string) (string, error) {
+	p, err := genum.ParsePackage(dir)
+	if err != nil {
+		return "", err
+	}
+	if p.Name != "" {
+		return p.Name, nil
+	}
+	dir, err = filepath.Abs(dir)
+	if err != nil {
+		return "", err
+	}
+	return filepath.Base(dir), nil
+}
+
+func runCheck(e env, args []string) int {
+	s, code, ok := parseSettings(e, "check", checkSummary, args)
+	if !ok {
+		return code
+	}
+	if s.packageName == "" {
+		// The package name is only required to write the file.
+		s.packageName = s.enumType
+	}
+	err := genum.Generate(genum.Check(s, e.args)...)
+	if err != nil {
+		return e.fail("check: %s", err)
+	}
+	return exitOK
+}
+
+func runList(e env, args []string) int {
+	s, code, ok := parseSettings(e, "list", listSummary, args)
+	if !ok {
+		return code
+	}
+	err := genum.Generate(genum.List(s, e.stdout)...)
+	if err != nil {
+		return e.fail("list: %s", err)
+	}
+	return exitOK
+}
+
+const (
+	csvFileExt = ".csv"
+	// initCSV is the content of the CSV file created by the init command.
+	initCSV = "name,value,deprecated,retired,aliases\nunknown,,,,\n"
+)
+
+func runInit(e env, args []string) int {
+	var (
+		s  = new(Settings)
+		fs = newFlagSet(e, "init", initSynopsis, initSummary)
+	)
+	fs.StringVar(&s.enumType, "name", genum.DefaultType, enumTypeUsage)
+	fs.StringVar(&s.enumKind, "type", genum.DefaultKind, enumKindUsage)
+	if code, ok := parseFlags(fs, args); !ok {
+		return code
+	}
+	filename := naming.SnakeCase(s.enumType) + csvFileExt
+	if fs.NArg() > 0 {
+		filename = fs.Arg(0)
+	}
+	f, err := os.OpenFile(filename, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0600)
+	if err != nil {
+		return e.fail("init: %s", err)
+	}
+	_, err = f.WriteString(initCSV)
+	if cerr := f.Close(); err == nil {
+		err = cerr
+	}
+	if err != nil {
+		return e.fail("init: %s", err)
+	}
+	_, _ = fmt.Fprintf(
+		e.stdout, "//go:generate %s gen -pkg ${GOPACKAGE} -name %s -type %s -header %s\n",
+		genum.Command, s.TypeName(), s.TypeKind().Name(), filepath.Base(filename),
+	)
+	return exitOK
+}
+
+// flags defines the flags of the settings in the flag set.
+func (s *Settings) flags(fs *flag.FlagSet) {
+	u := fmt.Sprintf(stringFormaterUsage, genum.NamePos, genum.ValuePos, genum.TypePos)
+	fs.StringVar(&s.packageName, "pkg", "", packageNameUsage)
+	fs.StringVar(&s.enumType, "name", genum.DefaultType, enumTypeUsage)
+	fs.StringVar(&s.enumKind, "type", genum.DefaultKind, enumKindUsage)
+	fs.StringVar(&s.output, "output", "", outputUsage)
+	fs.StringVar(&s.stringFormater, "stringer_format", genum.NameFormat(), u)
+	fs.BoolVar(&s.stringer, "stringer", false, stringerUsage)
+	fs.StringVar(&s.lookup, "lookup", genum.AutoLookup.String(), lookupUsage)
+	fs.IntVar(&s.switchMax, "switch_max", genum.DefaultSwitchMax, switchMaxUsage)
+	fs.BoolVar(&s.trimPrefix, "noprefix", false, noPrefixUsage)
+	fs.BoolVar(&s.joinPrefix, "prefix", false, prefixUsage)
+	fs.BoolVar(&s.flagValue, "flag", false, flagUsage)
+	fs.BoolVar(&s.graphQL, "graphql", false, graphQLUsage)
+	fs.StringVar(&s.graphQLSchema, "graphql_schema", "", graphQLSchemaUsage)
+	fs.BoolVar(&s.jsonMarshaler, "json", false, jsonUsage)
+	fs.BoolVar(&s.textMarshaler, "text", false, textUsage)
+	fs.BoolVar(&s.register, "register", false, registerUsage)
+	fs.BoolVar(&s.set, "set", false, setUsage)
+	fs.BoolVar(&s.ordered, "ordered", false, orderedUsage)
+	fs.StringVar(&s.order, "order", genum.DeclarationOrder.String(), orderUsage)
+	fs.StringVar(&s.transitions, "transitions", "", transitionsUsage)
+	fs.BoolVar(&s.tests, "tests", false, testsUsage)
+	fs.BoolVar(&s.xmlMarshaler, "xml", false, xmlUsage)
+	fs.BoolVar(&s.yamlMarshaler, "yaml", false, yamlUsage)
+	fs.BoolVar(&s.yamlNode, "yaml_node", false, yamlNodeUsage)
+	fs.BoolVar(&s.validator, "validator", false, validatorUsage)
+	fs.BoolVar(&s.values, "values", false, valuesUsage)
+	fs.BoolVar(&s.binary, "binary", false, binaryUsage)
+	fs.BoolVar(&s.comment, "comment", false, commentUsage)
+	fs.BoolVar(&s.noFmt, "nofmt", false, noFmtUsage)
+	fs.BoolVar(&s.closed, "closed", false, closedUsage)
+	fs.BoolVar(&s.open, "open", false, openUsage)
+	fs.StringVar(&s.unknown, "unknown", "", unknownUsage)
+	fs.StringVar(&s.deprecation, "deprecated", genum.AcceptDeprecated.String(), deprecatedUsage)
+	fs.BoolVar(&s.header, "header", false, headerUsage)
+	fs.BoolVar(&s.bitmask, "bitmask", false, bitmaskUsage)
+	fs.BoolVar(&s.iota, "iota", true, iotaUsage)
 }
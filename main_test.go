@@ -0,0 +1,133 @@
+// Copyright (c) 2021 Hervé Gouchet. All rights reserved.
+// Use of this source code is governed by the MIT License
+// that can be found in the LICENSE file.
+
+package main
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/matryer/is"
+)
+
+func TestRun(t *testing.T) {
+	t.Parallel()
+	var (
+		are = is.New(t)
+		dir = t.TempDir()
+		dt  = map[string]struct {
+			// inputs
+			args  []string
+			stdin string
+			// outputs
+			code   int
+			stdout string
+			stderr string
+		}{
+			"Default":      {code: exitFailure, stderr: "genum: source file: unexpected EOF\n"},
+			"Help":         {args: []string{"help"}, stdout: "commands:"},
+			"Help command": {args: []string{"help", "list"}, stdout: "usage: genum list [flags] [file]"},
+			"Help unknown": {args: []string{"help", "oops"}, code: exitFailure, stderr: `unknown command "oops"`},
+			"Help flag":    {args: []string{"-h"}, stdout: "commands:"},
+			"Help long":    {args: []string{"--help"}, stdout: "commands:"},
+			"Flag help":    {args: []string{"check", "-h"}, stderr: "usage: genum check [flags] [file]"},
+			"Unknown flag": {args: []string{"gen", "-oops"}, code: exitUsage, stderr: "-oops"},
+			"Invalid settings": {
+				args:   []string{"gen", "-prefix", "-noprefix", "testdata/hello.csv"},
+				code:   exitUsage,
+				stderr: "genum: settings: invalid data: -prefix and -noprefix are exclusive\n",
+			},
+			"Unknown transition": {
+				args:   []string{"check", "-transitions", "testdata/transitions.csv", "-type", "string", "testdata/hello.csv"},
+				code:   exitFailure,
+				stderr: "genum: check: transitions file: line 1: unknown state \"bye\": invalid data\n",
+			},
+			"Missing source": {args: []string{"gen", "testdata/oops.csv"}, code: exitFailure, stderr: "genum: source:"},
+			"Gen": {
+				args: []string{"-pkg", "test", "-output", dir, "testdata/hello.csv"},
+			},
+			"Gen command": {
+				args: []string{
+					"gen", "-name", "Hi", "-output", filepath.Join(dir, "hi", "hi.go"), "-stringer", "testdata/hello.csv",
+				},
+			},
+			"Gen clash": {
+				args:   []string{"gen", "-name", "Hello", "-output", filepath.Join(dir, "clash"), "testdata/hello.csv"},
+				code:   exitFailure,
+				stderr: "genum: invalid data: already declared: Hello at ",
+			},
+			"Gen package": {
+				args:   []string{"gen", "-pkg", "oops", "-output", filepath.Join(dir, "clash"), "testdata/hello.csv"},
+				code:   exitFailure,
+				stderr: "genum: package oops: invalid data: ",
+			},
+			"Check": {args: []string{"check", "-text", "testdata/hello.csv"}},
+			"Check stdin": {
+				args:   []string{"check", "-nofmt", "-stringer", "-stringer_format", "%5s"},
+				stdin:  "hello\n",
+				code:   exitFailure,
+				stderr: "genum: check: stringer format",
+			},
+			"List": {
+				args:   []string{"list", "-type", "uint8", "testdata/hello.csv"},
+				stdout: "CONSTANT  VALUE  TEXT     STATE\nHello     0      hello",
+			},
+			"Init": {
+				args:   []string{"init", "-name", "Status", filepath.Join(dir, "status.csv")},
+				stdout: "//go:generate genum gen -pkg ${GOPACKAGE} -name Status -type int -header status.csv\n",
+			},
+			"Init exists": {
+				args:   []string{"init", "testdata/hello.csv"},
+				code:   exitFailure,
+				stderr: "genum: init:",
+			},
+			"Extract": {
+				args:   []string{"extract", "-name", "Color", "testdata/extract"},
+				stdout: "name,value,deprecated\nRed,1,\nGreen,3,\nBlue,4,use Sky instead.\nSky,14,\n",
+			},
+			"Extract file": {
+				args:   []string{"extract", "-name", "Shade", "testdata/extract/color.go"},
+				stdout: "name,value\nDark,dark\n",
+			},
+			"Extract unknown": {
+				args:   []string{"extract", "-name", "Shape", "testdata/extract"},
+				code:   exitFailure,
+				stderr: "genum: extract: constants of Shape: missing data\n",
+			},
+		}
+	)
+	err := os.Mkdir(filepath.Join(dir, "clash"), 0750)
+	are.NoErr(err) // unexpected mkdir error
+	err = os.WriteFile(filepath.Join(dir, "clash", "doc.go"), []byte("package clash\n\nconst Hello = 1\n"), 0600)
+	are.NoErr(err) // unexpected write error
+	for name, tt := range dt {
+		tt := tt
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			var stdout, stderr bytes.Buffer
+			code := Run(tt.args, strings.NewReader(tt.stdin), &stdout, &stderr)
+			are.Equal(tt.code, code)                               // mismatch code
+			are.True(strings.Contains(stdout.String(), tt.stdout)) // mismatch stdout
+			are.True(strings.Contains(stderr.String(), tt.stderr)) // mismatch stderr
+			are.Equal(tt.stdout == "", stdout.Len() == 0)          // unexpected stdout
+			are.Equal(tt.stderr == "", stderr.Len() == 0)          // unexpected stderr
+		})
+	}
+}
+
+func TestRun_Init(t *testing.T) {
+	t.Parallel()
+	var (
+		are      = is.New(t)
+		filename = filepath.Join(t.TempDir(), "status.csv")
+		buf      bytes.Buffer
+	)
+	are.Equal(exitOK, Run([]string{"init", filename}, nil, &buf, &buf)) // unexpected code
+	b, err := os.ReadFile(filename)
+	are.NoErr(err)                // unexpected error
+	are.Equal(initCSV, string(b)) // mismatch content
+}
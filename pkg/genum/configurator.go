@@ -5,26 +5,86 @@
 package genum
 
 import (
+	"bytes"
 	"encoding/csv"
 	"errors"
 	"fmt"
 	"go/format"
+	"go/token"
 	"io"
 	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
 	"strings"
+	"text/tabwriter"
 )
 
 // Configurator must be implemented by any methods acted as an enum layout generator.
 type Configurator func(g *Generator) error
 
+// HandleDeprecated defines how the generated parsers handle the deprecated values.
+func HandleDeprecated(d Deprecation) Configurator {
+	return func(g *Generator) error {
+		g.deprecation = d
+		return nil
+	}
+}
+
+// HandleFmt defines if the generated code may import the fmt package.
+// Without it, the strings are concatenated and the values formatted with the strconv package.
+func HandleFmt(noFmt bool) Configurator {
+	return func(g *Generator) error {
+		g.nofmt = noFmt
+		return nil
+	}
+}
+
+// HandleDecoding defines how the generated decoders handle the unknown values.
+// With open decoding, the unknown constant, named as in the source, is used as unknown value.
+func HandleDecoding(d Decoding, unknown string) Configurator {
+	return func(g *Generator) error {
+		g.decoding = d
+		if unknown == "" {
+			return nil
+		}
+		if d != OpenDecoding {
+			return fmt.Errorf("unknown constant %q without open decoding: %w", unknown, ErrInvalid)
+		}
+		for _, e := range g.enums {
+			if e.Text != unnamed && e.RawText == unknown {
+				g.unknown = e.Text
+				return nil
+			}
+		}
+		return fmt.Errorf("unknown constant %q: %w", unknown, ErrMissing)
+	}
+}
+
+// HandleLookup defines the lookup of the enum names, with the maximum number of values looked up
+// with a switch statement when the lookup is automatically chosen. A maximum of 0 disables the switch statement.
+func HandleLookup(l Lookup, switchMax int) Configurator {
+	return func(g *Generator) error {
+		g.lookup = l
+		g.switchMax = switchMax
+		return nil
+	}
+}
+
 // ParseBitmask reads the given source as a CSV and tries to create a bitmasks list.
-func ParseBitmask(data io.Reader, enumType string, joinPrefix, trimPrefix bool) Configurator {
+// With header, the first line names the columns.
+func ParseBitmask(data io.Reader, enumType string, joinPrefix, trimPrefix, header bool) Configurator {
 	return func(g *Generator) error {
-		r := csv.NewReader(data)
-		r.FieldsPerRecord = -1 // Records may have a variable number of fields.
+		r, c, err := readCSV(data, header)
+		if err != nil {
+			return fmt.Errorf("source file: %w", err)
+		}
 		g.enums = make([]Enum, 0)
+		g.langs = c.langs()
+		g.metadata = c.metadata()
+		g.bitmask = true
 		var curIota uint64
-		for {
+		for prevEnd := 0; ; {
 			d, err := r.Read()
 			if err != nil {
 				if errors.Is(err, io.EOF) {
@@ -32,14 +92,16 @@ func ParseBitmask(data io.Reader, enumType string, joinPrefix, trimPrefix bool)
 				}
 				return fmt.Errorf("source file: %w", err)
 			}
+			e, err := newEnum(d, c, enumType, joinPrefix, trimPrefix)
+			if err != nil {
+				return fmt.Errorf("source file: %w", err)
+			}
+			start, end := recordLines(r, d)
+			e, prevEnd = followEnum(e, g.enums, start, prevEnd), end
 			curIota++
-			g.enums = append(g.enums, Enum{
-				Iota:    bitmaskIota(curIota),
-				Text:    enumName(d, enumType, joinPrefix, trimPrefix),
-				RawText: enumRawName(d),
-				Type:    enumType,
-				Value:   bitmaskValue(curIota),
-			})
+			e.Iota = bitmaskIota(curIota)
+			e.Value = bitmaskValue(curIota)
+			g.enums = append(g.enums, e)
 		}
 		enumKind := KindUnsigned(len(g.enums))
 		for k := range g.enums {
@@ -50,16 +112,25 @@ func ParseBitmask(data io.Reader, enumType string, joinPrefix, trimPrefix bool)
 }
 
 // ParseEnums reads the given source as a CSV and tries to create a list of constants based on it.
-func ParseEnums(data io.Reader, enumType string, enumKind Kind, joinPrefix, trimPrefix, useIota bool) Configurator {
+// With header, the first line names the columns.
+// The value of a retired constant can not be used by another one.
+func ParseEnums(
+	data io.Reader, enumType string, enumKind Kind, joinPrefix, trimPrefix, useIota, header bool,
+) Configurator {
 	return func(g *Generator) error {
 		var (
 			curIota           int64 = -1
 			curUint, prevUint uint64
 			curSign, prevSign bool
+			prevEnd           int
 		)
-		r := csv.NewReader(data)
-		r.FieldsPerRecord = -1 // Records may have a variable number of fields.
+		r, c, err := readCSV(data, header)
+		if err != nil {
+			return fmt.Errorf("source file: %w", err)
+		}
 		g.enums = make([]Enum, 0)
+		g.langs = c.langs()
+		g.metadata = c.metadata()
 		for {
 			d, err := r.Read()
 			if err != nil {
@@ -68,27 +139,101 @@ func ParseEnums(data io.Reader, enumType string, enumKind Kind, joinPrefix, trim
 				}
 				return fmt.Errorf("source file: %w", err)
 			}
-			e := Enum{
-				Type:    enumType,
-				Kind:    enumKind,
-				Text:    enumName(d, enumType, joinPrefix, trimPrefix),
-				RawText: enumRawName(d),
+			e, err := newEnum(d, c, enumType, joinPrefix, trimPrefix)
+			if err != nil {
+				return fmt.Errorf("source file: %w", err)
 			}
+			start, end := recordLines(r, d)
+			e, prevEnd = followEnum(e, g.enums, start, prevEnd), end
+			e.Kind = enumKind
 			if useIota {
 				curIota++
 			}
-			e.Value, e.Iota, curUint, curSign = enumValue(d, enumKind, curIota, prevUint, prevSign)
-			g.basic = enumKind.IsInteger() && absDiff(curUint, curSign, prevUint, prevSign) == 1
+			e.Value, e.Iota, curUint, curSign = enumValue(d, c, enumKind, curIota, prevUint, prevSign)
 			g.enums = append(g.enums, e)
 			prevUint, prevSign = curUint, curSign
 		}
 		if len(g.enums) == 0 {
 			return fmt.Errorf("source file: %w", io.ErrUnexpectedEOF)
 		}
+		return checkRetired(g.enums)
+	}
+}
+
+// PrintBinaryMarshaler adds methods to encode and decode the enum value as binary data, with the gob encoder too.
+// Numbers are encoded in big-endian order on the byte size of their kind, strings as is.
+// The int and uint kinds are always encoded on 8 bytes to not depend on the platform.
+// Decoded values must be known constants, or only be made of known flags with bitmask enabled.
+func PrintBinaryMarshaler(enumType string, bitmask bool) Configurator {
+	return func(g *Generator) error {
+		if len(g.enums) == 0 {
+			return fmt.Errorf("enum list: %w", ErrMissing)
+		}
+		enumKind := g.enums[0].Kind
+
+		// encoding.BinaryMarshaler
+		g.printf("\n")
+		g.printf("// MarshalBinary implements the encoding.BinaryMarshaler interface.\n")
+		g.printf("func (%s %s) MarshalBinary() (data []byte, err error) {\n", shortName, enumType)
+		g.printf(binaryFormat(enumKind))
+		g.printf("}\n")
+
+		// encoding.BinaryUnmarshaler
+		g.printf("\n")
+		g.printf("// UnmarshalBinary implements the encoding.BinaryUnmarshaler interface.\n")
+		g.printf("func (%s *%s) UnmarshalBinary(%s []byte) error {\n", shortName, enumType, srcName)
+		if n := enumKind.binaryBytes(); n > 0 {
+			g.printf("if len(%s) != %d {\n", srcName, n)
+			g.printf("return %s\n", g.invalidErr(enumType, "expects "+strconv.Itoa(n)+" bytes but got %d", "len("+srcName+")"))
+			g.printf("}\n")
+		}
+		g.printf("%s := %s\n", mixedName, binaryParse(enumType, enumKind))
+		if g.decoding == OpenDecoding {
+			g.printf("%s", g.decodeCheck(enumType, mixedName))
+			g.printf("*%s = %s\n", shortName, mixedName)
+			g.printf("return nil\n")
+			g.printf("}\n")
+			printGob(g, enumType)
+			return nil
+		}
+		if bitmask {
+			g.printf("if %s&^%#x != 0 {\n", mixedName, bitmaskMask(len(g.enums)))
+		} else {
+			g.printf("if _, ok := lookup%s(%s); !ok {\n", enumType, mixedName)
+		}
+		verb := "%v"
+		if enumKind == String {
+			verb = "%q"
+		}
+		g.printf("return %s\n", g.invalidErr(enumType, "unknown "+verb, enumKind.Cast(mixedName)))
+		g.printf("}\n")
+		g.printf("%s", g.deprecationCheck(enumType, mixedName))
+		g.printf("*%s = %s\n", shortName, mixedName)
+		g.printf("return nil\n")
+		g.printf("}\n")
+		printGob(g, enumType)
+
 		return nil
 	}
 }
 
+// printGob prints the methods to encode and decode the enum with gob, based on the binary ones.
+func printGob(g *Generator, enumType string) {
+	// gob.GobEncoder
+	g.printf("\n")
+	g.printf("// GobEncode implements the gob.GobEncoder interface.\n")
+	g.printf("func (%s %s) GobEncode() ([]byte, error) {\n", shortName, enumType)
+	g.printf("return %s.MarshalBinary()\n", shortName)
+	g.printf("}\n")
+
+	// gob.GobDecoder
+	g.printf("\n")
+	g.printf("// GobDecode implements the gob.GobDecoder interface.\n")
+	g.printf("func (%s *%s) GobDecode(%s []byte) error {\n", shortName, enumType, srcName)
+	g.printf("return %s.UnmarshalBinary(%s)\n", shortName, srcName)
+	g.printf("}\n")
+}
+
 // PrintBitmask prints related methods to bitmask operations.
 func PrintBitmask(enumType string) Configurator {
 	return func(g *Generator) error {
@@ -140,6 +285,52 @@ func PrintBitmask(enumType string) Configurator {
 	}
 }
 
+// PrintDeprecated adds a method to check if a constant is deprecated, when at least one of them is deprecated
+// or when the parsers have to handle them. With warning, the handler called by the parsers is declared too.
+func PrintDeprecated(enumType string) Configurator {
+	return func(g *Generator) error {
+		var names []string
+		for _, e := range distinctEnums(g.enums) {
+			if e.Deprecated != "" && e.Text != unnamed {
+				names = append(names, e.Text)
+			}
+		}
+		if len(names) == 0 && g.deprecation == AcceptDeprecated {
+			return nil
+		}
+		if g.deprecation == WarnDeprecated {
+			g.printf("\n")
+			g.printf("// Deprecated%[1]sHandler is called by the parsers with each deprecated %[1]s decoded.\n", enumType)
+			g.printf("var Deprecated%[1]sHandler = func(%[2]s %[1]s) {\n", enumType, shortName)
+			if g.nofmt {
+				g.printf(
+					"_, _ = os.Stderr.WriteString(%s + \" is a deprecated %s\\n\")\n", strConvValue(g.kind(), shortName), enumType,
+				)
+			} else {
+				g.printf("log.Printf(\"%%v is a deprecated %s\", %s)\n", enumType, shortName)
+			}
+			g.printf("}\n")
+		}
+		g.printf("\n")
+		g.printf("// IsDeprecated returns true if the %s is deprecated.\n", enumType)
+		g.printf("func (%s %s) IsDeprecated() bool {\n", shortName, enumType)
+		if len(names) == 0 {
+			g.printf("return false\n")
+			g.printf("}\n")
+			return nil
+		}
+		g.printf("switch %s {\n", shortName)
+		g.printf("case %s:\n", strings.Join(names, ", "))
+		g.printf("return true\n")
+		g.printf("default:\n")
+		g.printf("return false\n")
+		g.printf("}\n")
+		g.printf("}\n")
+
+		return nil
+	}
+}
+
 // PrintEnums prints the list of constants.
 func PrintEnums(enumType string, useIota, commented bool) Configurator {
 	return func(g *Generator) error {
@@ -149,13 +340,30 @@ func PrintEnums(enumType string, useIota, commented bool) Configurator {
 		g.printf("\n")
 		g.printf("// %s is an enum.\n", enumType)
 		g.printf("type %s %s\n", enumType, g.enums[0].Kind.Name())
-		g.printf("\n")
-		g.printf("// List of known %s enums.\n", enumType)
-		g.printf("const (\n")
-		for k, v := range g.enums {
-			g.printf(v.Format(k, useIota, commented))
+		blocks := enumBlocks(g.enums)
+		if len(blocks) > 1 {
+			// Iota restarts in each block.
+			useIota = false
+		}
+		for _, b := range blocks {
+			g.printf("\n")
+			if b[0].Group == "" {
+				g.printf("// List of known %s enums.\n", enumType)
+			} else {
+				g.printf("// List of %s enums in the %s group.\n", enumType, b[0].Group)
+			}
+			g.printf("const (\n")
+			for k, v := range b {
+				switch {
+				case v.Retired && v.RawText != "":
+					g.printf("// %s is retired, its value is reserved.\n", v.RawText)
+				case v.Deprecated != "":
+					g.printf("// Deprecated: %s\n", v.Deprecated)
+				}
+				g.printf("%s", v.Format(k, useIota, commented))
+			}
+			g.printf(")\n")
 		}
-		g.printf(")\n")
 
 		return nil
 	}
@@ -167,7 +375,18 @@ func PrintHeader(pkg string, args []string, packages map[string]struct{}) Config
 		if pkg == "" {
 			return fmt.Errorf("package RawName: %w", ErrMissing)
 		}
-		g.printf("// Code generated by %q; DO NOT EDIT.\n", Command+" "+strings.Join(args, " "))
+		if len(g.langs) > 0 {
+			// Labels are translated and parsed with these packages.
+			dep := map[string]struct{}{"errors": {}, "fmt": {}, "strings": {}}
+			if g.nofmt {
+				dep = map[string]struct{}{"errors": {}, "strconv": {}, "strings": {}}
+			}
+			for name := range packages {
+				dep[name] = struct{}{}
+			}
+			packages = dep
+		}
+		g.printf("// Code generated by %q; DO NOT EDIT.\n", generatedBy(args))
 		g.printf("\n")
 		g.printf("package %s\n", pkg)
 		g.printf("\n")
@@ -184,14 +403,134 @@ func PrintHeader(pkg string, args []string, packages map[string]struct{}) Config
 	}
 }
 
+// PrintFlagValue adds methods to use the enum as a command line flag, with a constant listing the allowed values.
+// With bitmask enabled, the methods are declared on a dedicated type to accept a list of flags
+// separated by a comma or a pipe, without overwriting the bitmask Set method.
+func PrintFlagValue(enumType string, bitmask bool) Configurator {
+	return func(g *Generator) error {
+		var (
+			recv  = enumType
+			names = make([]string, 0, len(g.enums))
+		)
+		for _, e := range g.enums {
+			if e.Text != unnamed {
+				names = append(names, strconv.Quote(e.RawText))
+			}
+		}
+		usage := "one of " + strings.Join(names, ", ")
+		if bitmask {
+			usage = "comma or pipe separated list of " + strings.Join(names, ", ")
+			recv = enumType + "Value"
+		}
+		g.printf("\n")
+		g.printf("// %sFlagUsage lists the values allowed on the command line.\n", enumType)
+		g.printf("const %sFlagUsage = %q\n", enumType, usage)
+
+		if bitmask {
+			g.printf("\n")
+			g.printf("// %s implements the flag.Value and pflag.Value interfaces on a %s.\n", recv, enumType)
+			g.printf("type %s %s\n", recv, enumType)
+			g.printf("\n")
+			g.printf("// FlagValue returns the %s as a value to set with a command line flag.\n", enumType)
+			g.printf("func (%s *%s) FlagValue() *%s {\n", shortName, enumType, recv)
+			g.printf("return (*%s)(%s)\n", recv, shortName)
+			g.printf("}\n")
+		}
+
+		// flag.Value
+		g.printf("\n")
+		g.printf("// Set implements the flag.Value interface.\n")
+		g.printf("func (%s *%s) Set(%s string) error {\n", shortName, recv, strName)
+		if bitmask {
+			g.printf("var %s %s\n", mixedName, enumType)
+			g.printf("for _, f := range strings.FieldsFunc(%s, func(r rune) bool { return r == ',' || r == '|' }) {\n", strName)
+			g.printf("var %s2 %s\n", shortName, enumType)
+			g.printf("err := %s2.UnmarshalText([]byte(strings.TrimSpace(f)))\n", shortName)
+			g.printf("if err != nil {\n")
+			g.printf("return err\n")
+			g.printf("}\n")
+			g.printf("%s.Set(%s2)\n", mixedName, shortName)
+			g.printf("}\n")
+			g.printf("*%s = %s(%s)\n", shortName, recv, mixedName)
+			g.printf("return nil\n")
+		} else {
+			g.printf("return %s.UnmarshalText([]byte(%s))\n", shortName, strName)
+		}
+		g.printf("}\n")
+
+		if bitmask {
+			g.printf("\n")
+			g.printf("// String implements the flag.Value interface.\n")
+			g.printf("func (%s *%s) String() string {\n", shortName, recv)
+			g.printf("if %s == nil {\n", shortName)
+			g.printf("return \"\"\n")
+			g.printf("}\n")
+			g.printf("var names []string\n")
+			g.printf("for i := 0; i < %d; i++ {\n", len(g.enums))
+			g.printf("if %[1]s := %[2]s(1) << i; %[2]s(*%[3]s).Has(%[1]s) {\n", mixedName, enumType, shortName)
+			g.printf("names = append(names, %s.String())\n", mixedName)
+			g.printf("}\n")
+			g.printf("}\n")
+			g.printf("return strings.Join(names, \",\")\n")
+			g.printf("}\n")
+		}
+
+		// pflag.Value
+		g.printf("\n")
+		g.printf("// Type implements the pflag.Value interface.\n")
+		g.printf("func (%s *%s) Type() string {\n", shortName, recv)
+		g.printf("return %q\n", enumType)
+		g.printf("}\n")
+
+		return nil
+	}
+}
+
+// PrintGraphQLMarshaler adds methods to marshal and unmarshal the enum String value as a GraphQL enum.
+func PrintGraphQLMarshaler(enumType string) Configurator {
+	return func(g *Generator) error {
+		// graphql.Marshaler
+		g.printf("\n")
+		g.printf("// MarshalGQL implements the graphql.Marshaler interface.\n")
+		g.printf("func (%s %s) MarshalGQL(w io.Writer) {\n", shortName, enumType)
+		g.printf("_, _ = io.WriteString(w, strconv.Quote(%s.String()))\n", shortName)
+		g.printf("}\n")
+
+		// graphql.Unmarshaler
+		g.printf("\n")
+		g.printf("// UnmarshalGQL implements the graphql.Unmarshaler interface.\n")
+		g.printf("func (%s *%s) UnmarshalGQL(v interface{}) error {\n", shortName, enumType)
+		g.printf("%s, ok := v.(string)\n", strName)
+		g.printf("if !ok {\n")
+		if g.nofmt {
+			// The type of the value requires the reflection.
+			g.printf("return %s\n", g.invalidErr(enumType, "expects string"))
+		} else {
+			g.printf("return %s\n", g.invalidErr(enumType, "expects string but got %T", "v"))
+		}
+		g.printf("}\n")
+		g.printf("return %s.UnmarshalText([]byte(%s))\n", shortName, strName)
+		g.printf("}\n")
+
+		return nil
+	}
+}
+
 // PrintJSONMarshaler adds methods to marshal and unmarshal the enum value as JSON data.
 func PrintJSONMarshaler(enumType string, enumKind Kind) Configurator {
 	return func(g *Generator) error {
+		// Append-style encoder, without allocation.
+		g.printf("\n")
+		g.printf("// AppendJSON appends the JSON encoding of the %s to b.\n", enumType)
+		g.printf("func (%s %s) AppendJSON(b []byte) ([]byte, error) {\n", shortName, enumType)
+		g.printf(strConvAppendJSON(enumKind))
+		g.printf("}\n")
+
 		// json.Marshaler
 		g.printf("\n")
 		g.printf("// MarshalJSON implements the json.Marshaler interface.\n")
-		g.printf("func (e %s) MarshalJSON() ([]byte, error) {\n", enumType)
-		g.printf("return json.Marshal(%s)", strConvFormat(enumKind))
+		g.printf("func (%s %s) MarshalJSON() ([]byte, error) {\n", shortName, enumType)
+		g.printf("return %s.AppendJSON(make([]byte, 0, %d))\n", shortName, jsonBufSize)
 		g.printf("}\n")
 
 		// json.Unmarshaler
@@ -202,9 +541,9 @@ func PrintJSONMarshaler(enumType string, enumKind Kind) Configurator {
 		g.printf("err = json.Unmarshal(data, &s)\n")
 		g.printf(")\n")
 		g.printf("if err != nil {\n")
-		g.printf(returnErr, enumType, enumKind.Name(), srcName)
+		g.printf("%s", g.expectsErr(enumType, enumKind, srcName))
 		g.printf("}\n")
-		g.printf(strConvParse(enumType, enumKind))
+		g.printf(strConvParse(enumType, enumKind, g.decodeCheck, g.expectsErr(enumType, enumKind, strName)))
 		g.printf("return nil\n")
 		g.printf("}\n")
 
@@ -222,115 +561,124 @@ func PrintValidator(enumType string) Configurator {
 		g.printf("return ok\n")
 		g.printf("}\n")
 
+		g.printf("\n")
+		g.printf("// Validate returns ErrInvalid%s if the %s is not a known constant.\n", enumType, enumType)
+		g.printf("func (%s %s) Validate() error {\n", shortName, enumType)
+		g.printf("if !%s.IsValid() {\n", shortName)
+		g.printf("return %s\n", g.invalidErr(enumType, "unknown %v", shortName))
+		g.printf("}\n")
+		g.printf("return nil\n")
+		g.printf("}\n")
+
 		return nil
 	}
 }
 
-// PrintStringer chooses the "best" methods regarding the data to manage the String method.
-func PrintStringer(format string, enumType string, enumKind Kind) Configurator {
+// PrintValues adds a method returning the known constants, to satisfy the generic interface of the enum package.
+func PrintValues(enumType string) Configurator {
 	return func(g *Generator) error {
 		g.printf("\n")
-		g.printf("// String implements the fmt.Stringer interface.\n")
-		g.printf("func (%s %s) String() string {\n", shortName, enumType)
-		g.printf("s, ok := lookup%s(%s)\n", enumType, shortName)
-		g.printf("if !ok {")
-		g.printf(
-			"return fmt.Sprintf(%q, %q, %v, %q)\n",
-			DefaultFormat(enumKind.ValueFormat()), "", enumKind.Cast(shortName), enumType,
-		)
-		g.printf("}\n")
-		if format != NameFormat() {
-			g.printf("return fmt.Sprintf(%q, %s, %v, %q)\n", format, strName, enumKind.Cast(shortName), enumType)
-		} else {
-			g.printf("return %s\n", strName)
+		g.printf("// Values returns the known constants of %s, in their declaration order.\n", enumType)
+		g.printf("func (%s) Values() []%s {\n", enumType, enumType)
+		g.printf("return []%s{\n", enumType)
+		for _, e := range distinctEnums(g.enums) {
+			if e.Text != unnamed {
+				g.printf("%s,\n", e.Text)
+			}
 		}
 		g.printf("}\n")
+		g.printf("}\n")
 
 		return nil
 	}
 }
 
-// PrintLookup adds a private method dedicated to get if exists the name of a constant with ok at true.
-// Otherwise, a standard failover name with ok at false are returned.
-func PrintLookup(enumType string, enumKind Kind) Configurator {
+// PrintSet builds the set type of the enum with its membership operations, backed by a bitset
+// when the integer values are in a small range, by a map otherwise.
+func PrintSet(enumType string, enumKind Kind) Configurator {
 	return func(g *Generator) error {
-		switch g.mode() {
-		case basic:
-			return g.basicString(enumType, enumKind)
-		case average:
-			return g.averageString(enumType)
-		default:
-			return g.advanceString(enumType)
+		setType := enumType + setSuffix
+		g.printf("\n")
+		g.printf("var _%sValues = [...]%s{", setType, enumType)
+		for k, e := range sortedEnums(g.enums) {
+			if k > 0 {
+				g.printf(", ")
+			}
+			g.printf("%s", e.Text)
+		}
+		g.printf("}\n")
+
+		if span, ok := setSpan(g.enums); ok {
+			g.bitsetSet(setType, enumType, enumKind, span)
+		} else {
+			g.mapSet(setType, enumType)
 		}
-	}
-}
 
-// PrintTextMarshaler adds methods to marshal and unmarshal the enum String value as a text.
-func PrintTextMarshaler(format string, enumType string) Configurator {
-	return func(g *Generator) error {
-		// encoding.TextMarshaler
 		g.printf("\n")
-		g.printf("// MarshalText implements the encoding.TextMarshaler interface.\n")
-		g.printf("func (e %s) MarshalText() (text []byte, err error) {\n", enumType)
-		g.printf("return []byte(e.String()), nil")
+		g.printf("// New%s returns a set with the known constants among the given values.\n", setType)
+		g.printf("func New%s(values ...%s) %s {\n", setType, enumType, setType)
+		g.printf("var %s %s\n", strName, setType)
+		g.printf("%s.Add(values...)\n", strName)
+		g.printf("return %s\n", strName)
 		g.printf("}\n")
 
-		// encoding.TextUnmarshaler
-		g.printf("var _%sStrings = map[string]%s{\n", enumType, enumType)
-		var (
-			v   interface{}
-			err error
-		)
-		for k, e := range g.enums {
-			if format != NameFormat() {
-				v, err = e.ParseValue()
-				if err != nil {
-					return fmt.Errorf("enum value #%d: %w", k, err)
-				}
-				g.printf("%q: %s,\n", fmt.Sprintf(format, e.RawText, v, enumType), e.Text)
-			} else {
-				g.printf("%q: %s,\n", e.RawText, e.Text)
-			}
-		}
+		g.printf("\n")
+		g.printf("// Values returns the constants of the set, sorted by value.\n")
+		g.printf("func (%s %s) Values() []%s {\n", strName, setType, enumType)
+		g.printf("res := make([]%s, 0, %s.Len())\n", enumType, strName)
+		g.printf("for _, %s := range _%sValues {\n", shortName, setType)
+		g.printf("if %s.Contains(%s) {\n", strName, shortName)
+		g.printf("res = append(res, %s)\n", shortName)
+		g.printf("}\n")
+		g.printf("}\n")
+		g.printf("return res\n")
 		g.printf("}\n")
 
-		// encoding.TextUnmarshaler
 		g.printf("\n")
-		g.printf("// UnmarshalText implements the encoding.TextUnmarshaler interface.\n")
-		g.printf("func (e *%s) UnmarshalText(text []byte) error{\n", enumType)
-		g.printf("%s2, ok := _%sStrings[string(text)]\n", shortName, enumType)
-		g.printf("if !ok {\n")
-		g.printf("return fmt.Errorf(\"%%q is not a known %s\", text)\n", enumType)
+		g.printf("// String implements the fmt.Stringer interface.\n")
+		g.printf("func (%s %s) String() string {\n", strName, setType)
+		g.printf("var buf strings.Builder\n")
+		g.printf("_ = buf.WriteByte('[')\n")
+		g.printf("for k, %s := range %s.Values() {\n", shortName, strName)
+		g.printf("if k > 0 {\n")
+		g.printf("_ = buf.WriteByte(' ')\n")
 		g.printf("}\n")
-		g.printf("*%s = %s2\n", shortName, shortName)
-		g.printf("return nil\n")
+		g.printf("_, _ = buf.WriteString(%s.String())\n", shortName)
+		g.printf("}\n")
+		g.printf("_ = buf.WriteByte(']')\n")
+		g.printf("return buf.String()\n")
 		g.printf("}\n")
 
 		return nil
 	}
 }
 
-// PrintXMLMarshaler adds methods to marshal and unmarshal the enum value as XML data.
-func PrintXMLMarshaler(enumType string, enumKind Kind) Configurator {
+// PrintSetJSONMarshaler adds methods to marshal and unmarshal the set of the enum as a JSON list of constants.
+func PrintSetJSONMarshaler(enumType string) Configurator {
 	return func(g *Generator) error {
-		// json.Marshaler
+		setType := enumType + setSuffix
 		g.printf("\n")
-		g.printf("// MarshalXML implements the xml.Marshaler interface.\n")
-		g.printf("func (e %s) MarshalXML(x *xml.Encoder, start xml.StartElement) error {\n", enumType)
-		g.printf("return x.EncodeElement(%s, start)", strConvFormat(enumKind))
+		g.printf("// MarshalJSON implements the json.Marshaler interface, the set is encoded as a list of constants.\n")
+		g.printf("func (%s %s) MarshalJSON() ([]byte, error) {\n", strName, setType)
+		g.printf("return json.Marshal(%s.Values())\n", strName)
 		g.printf("}\n")
 
-		// json.Unmarshaler
-		g.printf("// UnmarshalXML implements the xml.Unmarshaler interface.\n")
-		g.printf("func (e *%s) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error{\n", enumType)
-		g.printf("var (\n")
-		g.printf("s string\n")
-		g.printf("err = d.DecodeElement(&s, &start)\n")
-		g.printf(")\n")
+		g.printf("\n")
+		g.printf("// UnmarshalJSON implements the json.Unmarshaler interface, any unknown constant is rejected.\n")
+		g.printf("func (%s *%s) UnmarshalJSON(%s []byte) error {\n", strName, setType, srcName)
+		g.printf("var values []%s\n", enumType)
+		g.printf("err := json.Unmarshal(%s, &values)\n", srcName)
+		g.printf("if err != nil {\n")
+		g.printf("return err\n")
+		g.printf("}\n")
+		g.printf("*%s = %s{}\n", strName, setType)
+		g.printf("for _, %s := range values {\n", shortName)
+		g.printf("err = %s.Validate()\n", shortName)
 		g.printf("if err != nil {\n")
-		g.printf(returnErr, enumType, enumKind.Name(), strName)
+		g.printf("return err\n")
+		g.printf("}\n")
+		g.printf("%s.Add(%s)\n", strName, shortName)
 		g.printf("}\n")
-		g.printf(strConvParse(enumType, enumKind))
 		g.printf("return nil\n")
 		g.printf("}\n")
 
@@ -338,27 +686,936 @@ func PrintXMLMarshaler(enumType string, enumKind Kind) Configurator {
 	}
 }
 
-// WriteFile tries to write the go file.
-func WriteFile(filename string) Configurator {
+// PrintOrdered builds the methods comparing and walking through the constants in the given order,
+// with the constants naming the first and the last ones. Unknown values come after the known ones.
+func PrintOrdered(enumType string, order Order) Configurator {
 	return func(g *Generator) error {
-		if filename == "" {
-			return fmt.Errorf("destination filename: %w", ErrMissing)
+		enums := namedEnums(g.enums)
+		if order == ValueOrder {
+			enums = sortedEnums(g.enums)
 		}
-		if g.err != nil {
-			return g.err
+		if len(enums) == 0 {
+			return fmt.Errorf("ordered enum list: %w", ErrMissing)
 		}
-		src, err := format.Source(g.buf.Bytes())
-		if err != nil {
-			// Allows the user to compile the output to see the error.
-			src = g.buf.Bytes()
+		names := make([]string, len(enums))
+		for k, e := range enums {
+			names[k] = e.Text
 		}
-		wrr := ioutil.WriteFile(filename, src, 0600)
-		if wrr != nil {
-			return fmt.Errorf("destination: %w", wrr)
+		g.printf("\n")
+		g.printf("// List of the edge %s constants, in the %s order.\n", enumType, order)
+		g.printf("const (\n")
+		g.printf("First%s = %s\n", enumType, names[0])
+		g.printf("Last%s = %s\n", enumType, names[len(names)-1])
+		g.printf(")\n")
+
+		g.printf("\n")
+		g.printf("var _%sOrder = [...]%s{%s}\n", enumType, enumType, strings.Join(names, ", "))
+
+		g.printf("\n")
+		g.printf("// position%[1]s returns the position of the %[1]s in the order, its length if unknown.\n", enumType)
+		g.printf("func position%s(%s %s) int {\n", enumType, shortName, enumType)
+		g.printf("switch %s {\n", shortName)
+		for k, name := range names {
+			g.printf("case %s:\n", name)
+			g.printf("return %d\n", k)
 		}
-		if err != nil {
-			return fmt.Errorf("go format failed: %w", wrr)
+		g.printf("}\n")
+		g.printf("return len(_%sOrder)\n", enumType)
+		g.printf("}\n")
+
+		g.printf("\n")
+		g.printf("// Compare returns -1 if the %s is before o, +1 if it is after and 0 if they are equal.\n", enumType)
+		g.printf("// The known constants follow the %s order, the unknown values come after, sorted by value.\n", order)
+		g.printf("func (%s %s) Compare(o %s) int {\n", shortName, enumType, enumType)
+		if order == ValueOrder {
+			g.printf("known := position%[1]s(%[2]s) < len(_%[1]sOrder)\n", enumType, shortName)
+			g.printf("oKnown := position%[1]s(o) < len(_%[1]sOrder)\n", enumType)
+			g.printf("switch {\n")
+			g.printf("case known && !oKnown, known == oKnown && %s < o:\n", shortName)
+			g.printf("return -1\n")
+			g.printf("case !known && oKnown, known == oKnown && %s > o:\n", shortName)
+		} else {
+			g.printf("i, j := position%[1]s(%[2]s), position%[1]s(o)\n", enumType, shortName)
+			g.printf("switch {\n")
+			g.printf("case i < j, i == j && %s < o:\n", shortName)
+			g.printf("return -1\n")
+			g.printf("case i > j, i == j && %s > o:\n", shortName)
 		}
+		g.printf("return 1\n")
+		g.printf("default:\n")
+		g.printf("return 0\n")
+		g.printf("}\n")
+		g.printf("}\n")
+
+		g.printf("\n")
+		g.printf("// Less returns true if the %s is before o.\n", enumType)
+		g.printf("func (%s %s) Less(o %s) bool {\n", shortName, enumType, enumType)
+		g.printf("return %s.Compare(o) < 0\n", shortName)
+		g.printf("}\n")
+
+		g.printf("\n")
+		g.printf("// Next returns the constant following the %s, and false if it is the last one or unknown.\n", enumType)
+		g.printf("func (%s %s) Next() (%s, bool) {\n", shortName, enumType, enumType)
+		g.printf("i := position%s(%s) + 1\n", enumType, shortName)
+		g.printf("if i >= len(_%sOrder) {\n", enumType)
+		g.printf("return %s, false\n", shortName)
+		g.printf("}\n")
+		g.printf("return _%sOrder[i], true\n", enumType)
+		g.printf("}\n")
+
+		g.printf("\n")
+		g.printf("// Prev returns the constant preceding the %s, and false if it is the first one or unknown.\n", enumType)
+		g.printf("func (%s %s) Prev() (%s, bool) {\n", shortName, enumType, enumType)
+		g.printf("i := position%s(%s) - 1\n", enumType, shortName)
+		g.printf("if i < 0 || i >= len(_%sOrder)-1 {\n", enumType)
+		g.printf("return %s, false\n", shortName)
+		g.printf("}\n")
+		g.printf("return _%sOrder[i], true\n", enumType)
+		g.printf("}\n")
+
 		return nil
 	}
 }
+
+// ParseTransitions reads the allowed transitions between the parsed constants, as CSV records "from,to"
+// naming the constants like the enum source, with an optional "from,to" header.
+func ParseTransitions(data io.Reader) Configurator {
+	return func(g *Generator) error {
+		if data == nil {
+			return fmt.Errorf("transitions file: %w", ErrMissing)
+		}
+		names := make(map[string]string, len(g.enums))
+		for _, e := range distinctEnums(g.enums) {
+			if e.Text == unnamed {
+				continue
+			}
+			for _, c := range g.enums {
+				if c.Text != unnamed && !c.Retired && enumKey(c) == enumKey(e) {
+					names[c.RawText] = e.Text
+					names[c.Text] = e.Text
+					for _, a := range c.Aliases {
+						names[a] = e.Text
+					}
+				}
+			}
+		}
+		r := csv.NewReader(data)
+		r.FieldsPerRecord = 2
+		r.TrimLeadingSpace = true
+		g.transitions = make(map[string][]string)
+		for {
+			d, err := r.Read()
+			if errors.Is(err, io.EOF) {
+				return nil
+			}
+			if err != nil {
+				return fmt.Errorf("transitions file: %w", err)
+			}
+			line, _ := r.FieldPos(0)
+			if line == 1 && strings.EqualFold(d[0], fromColumn) && strings.EqualFold(d[1], toColumn) {
+				continue
+			}
+			from, ok := names[d[0]]
+			if !ok {
+				return fmt.Errorf("transitions file: line %d: unknown state %q: %w", line, d[0], ErrInvalid)
+			}
+			to, ok := names[d[1]]
+			if !ok {
+				return fmt.Errorf("transitions file: line %d: unknown state %q: %w", line, d[1], ErrInvalid)
+			}
+			if !containsString(g.transitions[from], to) {
+				g.transitions[from] = append(g.transitions[from], to)
+			}
+		}
+	}
+}
+
+// PrintTransitions builds the transition table of the constants with the methods checking them.
+func PrintTransitions(enumType string) Configurator {
+	return func(g *Generator) error {
+		g.printf("\n")
+		g.printf("var _%sTransitions = map[%s][]%s{\n", enumType, enumType, enumType)
+		for _, e := range distinctEnums(g.enums) {
+			if next := g.transitions[e.Text]; len(next) > 0 {
+				g.printf("%s: {%s},\n", e.Text, strings.Join(next, ", "))
+			}
+		}
+		g.printf("}\n")
+
+		g.printf("\n")
+		g.printf("// CanTransitionTo returns true if the %s can change to the next one.\n", enumType)
+		g.printf("func (%s %s) CanTransitionTo(next %s) bool {\n", shortName, enumType, enumType)
+		g.printf("for _, %s := range _%sTransitions[%s] {\n", mixedName, enumType, shortName)
+		g.printf("if %s == next {\n", mixedName)
+		g.printf("return true\n")
+		g.printf("}\n")
+		g.printf("}\n")
+		g.printf("return false\n")
+		g.printf("}\n")
+
+		g.printf("\n")
+		g.printf("// NextStates returns the constants to which the %s can change.\n", enumType)
+		g.printf("func (%s %s) NextStates() []%s {\n", shortName, enumType, enumType)
+		g.printf("return append([]%s(nil), _%sTransitions[%s]...)\n", enumType, enumType, shortName)
+		g.printf("}\n")
+
+		g.printf("\n")
+		g.printf("// IsTerminal returns true if the %s is valid and can not change anymore.\n", enumType)
+		g.printf("func (%s %s) IsTerminal() bool {\n", shortName, enumType)
+		g.printf("return %[2]s.IsValid() && len(_%[1]sTransitions[%[2]s]) == 0\n", enumType, shortName)
+		g.printf("}\n")
+
+		return nil
+	}
+}
+
+// PrintRegister adds an init function registering the enum type with its constants, their names and its text parser,
+// to list and parse it at runtime with the registry package.
+// The names are formatted as by the Stringer, so each of them is parsed as its constant.
+func PrintRegister(format, enumType string, enumKind Kind) Configurator {
+	return func(g *Generator) error {
+		zeroValue := zero
+		if enumKind == String {
+			zeroValue = `""`
+		}
+		var values, names []string
+		for k, e := range distinctEnums(g.enums) {
+			if e.Text == unnamed {
+				continue
+			}
+			s, err := enumText(e, format)
+			if err != nil {
+				return fmt.Errorf("enum value #%d: %w", k, err)
+			}
+			values = append(values, e.Text)
+			names = append(names, strconv.Quote(s))
+		}
+		g.printf("\n")
+		g.printf("func init() {\n")
+		g.printf("registry.Register(\n")
+		g.printf("%s(%s),\n", enumType, zeroValue)
+		g.printf("[]interface{}{%s},\n", strings.Join(values, ", "))
+		g.printf("[]string{%s},\n", strings.Join(names, ", "))
+		g.printf("func(text string) (interface{}, error) {\n")
+		g.printf("var %s %s\n", shortName, enumType)
+		g.printf("err := %s.UnmarshalText([]byte(text))\n", shortName)
+		g.printf("if err != nil {\n")
+		g.printf("return nil, err\n")
+		g.printf("}\n")
+		g.printf("return %s, nil\n", shortName)
+		g.printf("},\n")
+		g.printf(")\n")
+		g.printf("}\n")
+
+		return nil
+	}
+}
+
+// PrintStringer chooses the "best" methods regarding the data to manage the String method.
+func PrintStringer(format string, enumType string, enumKind Kind) Configurator {
+	return func(g *Generator) error {
+		g.printf("\n")
+		g.printf("// String implements the fmt.Stringer interface.\n")
+		g.printf("func (%s %s) String() string {\n", shortName, enumType)
+		g.printf("s, ok := lookup%s(%s)\n", enumType, shortName)
+		if g.nofmt {
+			return g.concatStringer(format, enumType, enumKind)
+		}
+		g.printf("if !ok {")
+		g.printf(
+			"return fmt.Sprintf(%q, %q, %v, %q)\n",
+			DefaultFormat(enumKind.ValueFormat()), "", enumKind.Cast(shortName), enumType,
+		)
+		g.printf("}\n")
+		if format != NameFormat() {
+			g.printf("return fmt.Sprintf(%q, %s, %v, %q)\n", format, strName, enumKind.Cast(shortName), enumType)
+		} else {
+			g.printf("return %s\n", strName)
+		}
+		g.printf("}\n")
+
+		return nil
+	}
+}
+
+// PrintInvalidError declares the error returned by the decoders with an invalid value, if required by them
+// or by the labels.
+func PrintInvalidError(enumType string, decoders bool) Configurator {
+	return func(g *Generator) error {
+		if !decoders && len(g.langs) == 0 {
+			return nil
+		}
+		g.printf("\n")
+		g.printf("// ErrInvalid%[1]s is returned, wrapped, by the decoders of %[1]s with an invalid value.\n", enumType)
+		g.printf("var ErrInvalid%[1]s = errors.New(\"invalid %[1]s\")\n", enumType)
+		if !g.nofmt {
+			return nil
+		}
+		g.printf("\n")
+		g.printf("// invalid%[1]sError wraps ErrInvalid%[1]s with the detail of the invalid value, without fmt.\n", enumType)
+		g.printf("type invalid%sError string\n", enumType)
+		g.printf("\n")
+		g.printf("// Error implements the error interface.\n")
+		g.printf("func (%s invalid%sError) Error() string {\n", shortName, enumType)
+		g.printf("return ErrInvalid%s.Error() + \": \" + string(%s)\n", enumType, shortName)
+		g.printf("}\n")
+		g.printf("\n")
+		g.printf("// Unwrap returns ErrInvalid%s.\n", enumType)
+		g.printf("func (%s invalid%sError) Unwrap() error {\n", shortName, enumType)
+		g.printf("return ErrInvalid%s\n", enumType)
+		g.printf("}\n")
+
+		return nil
+	}
+}
+
+// PrintGroups builds the group type of the enum, with the methods returning the group of a constant
+// and the constants of a group.
+func PrintGroups(enumType string) Configurator {
+	return func(g *Generator) error {
+		groups := enumGroups(g.enums)
+		if len(groups) == 0 {
+			return nil
+		}
+		var (
+			groupType = enumType + groupSuffix
+			names     = make(map[string]string, len(groups))
+		)
+		for _, group := range groups {
+			name := groupName(enumType, group)
+			if !token.IsIdentifier(name) || name == groupType {
+				return fmt.Errorf("group %q: %w", group, ErrInvalid)
+			}
+			if prev, ok := names[name]; ok {
+				return fmt.Errorf("group %q: constant %s of %q: %w", group, name, prev, ErrInvalid)
+			}
+			names[name] = group
+		}
+		g.printf("\n")
+		g.printf("// %s is a group of %s constants.\n", groupType, enumType)
+		g.printf("type %s string\n", groupType)
+		g.printf("\n")
+		g.printf("// List of %s groups.\n", enumType)
+		g.printf("const (\n")
+		for _, group := range groups {
+			g.printf("%s %s = %q\n", groupName(enumType, group), groupType, group)
+		}
+		g.printf(")\n")
+
+		var (
+			enums   = namedEnums(g.enums)
+			members = make(map[string][]string, len(groups))
+		)
+		for _, e := range enums {
+			if e.Group != "" {
+				members[e.Group] = append(members[e.Group], e.Text)
+			}
+		}
+		g.printf("\n")
+		g.printf("// Group returns the group of the %s, empty if unknown or without group.\n", enumType)
+		g.printf("func (%s %s) Group() %s {\n", shortName, enumType, groupType)
+		g.printf("switch %s {\n", shortName)
+		for _, group := range groups {
+			if len(members[group]) > 0 {
+				g.printf("case %s:\n", strings.Join(members[group], ", "))
+				g.printf("return %s\n", groupName(enumType, group))
+			}
+		}
+		g.printf("}\n")
+		g.printf(returnEmpty)
+		g.printf("}\n")
+
+		g.printf("\n")
+		g.printf("// %sValuesIn returns the %s constants of the group, in their declaration order.\n", enumType, enumType)
+		g.printf("func %sValuesIn(group %s) []%s {\n", enumType, groupType, enumType)
+		g.printf("switch group {\n")
+		for _, group := range groups {
+			if len(members[group]) > 0 {
+				g.printf("case %s:\n", groupName(enumType, group))
+				g.printf("return []%s{%s}\n", enumType, strings.Join(members[group], ", "))
+			}
+		}
+		g.printf("}\n")
+		g.printf("return nil\n")
+		g.printf("}\n")
+
+		return nil
+	}
+}
+
+// PrintMetadata builds the accessor methods of the typed metadata columns, backed by arrays indexed
+// by constant, the strings being concatenated together.
+// Constants sharing the same value must have the same metadata.
+func PrintMetadata(enumType string) Configurator {
+	return func(g *Generator) error {
+		if len(g.metadata) == 0 {
+			return nil
+		}
+		err := checkMetadata(g.enums, g.metadata)
+		if err != nil {
+			return err
+		}
+		enums := namedEnums(g.enums)
+		g.printf("\n")
+		g.printf("func metaIndex%[1]s(%[2]s %[1]s) int {\n", enumType, shortName)
+		g.printf("switch %s {\n", shortName)
+		for k, e := range enums {
+			g.printf("case %s:\n", e.Text)
+			g.printf("return %d\n", k)
+		}
+		g.printf("default:\n")
+		g.printf("return -1\n")
+		g.printf("}\n")
+		g.printf("}\n")
+
+		for _, m := range g.metadata {
+			name := "_" + enumType + m.method()
+			if m.typ == stringType {
+				var (
+					buf = new(bytes.Buffer)
+					pos = []string{zero}
+				)
+				for _, e := range enums {
+					s, _ := strconv.Unquote(e.Metadata[m.name])
+					_, _ = buf.WriteString(s)
+					pos = append(pos, strconv.Itoa(buf.Len()))
+				}
+				g.printf("\n")
+				g.printf("const %sValues = %q\n", name, buf.String())
+				g.printf("\n")
+				g.printf("var %sIndexes = [...]uint%d{%s}\n", name, unsignedSize(buf.Len()), strings.Join(pos, ", "))
+			} else {
+				values := make([]string, len(enums))
+				for k, e := range enums {
+					values[k] = e.Metadata[m.name]
+				}
+				g.printf("\n")
+				g.printf("var %sValues = [...]%s{%s}\n", name, m.typ, strings.Join(values, ", "))
+			}
+
+			g.printf("\n")
+			g.printf("// %s returns the %s of the %s, its zero value if unknown.\n", m.method(), m.name, enumType)
+			g.printf("func (%s %s) %s() %s {\n", shortName, enumType, m.method(), m.typ)
+			g.printf("k := metaIndex%s(%s)\n", enumType, shortName)
+			switch m.typ {
+			case stringType:
+				g.printf("if k < 0 {\n")
+				g.printf(returnEmpty)
+				g.printf("}\n")
+				g.printf("return %[1]sValues[%[1]sIndexes[k]:%[1]sIndexes[k+1]]\n", name)
+			case boolType:
+				g.printf("return k >= 0 && %sValues[k]\n", name)
+			default:
+				g.printf("if k < 0 {\n")
+				g.printf("return 0\n")
+				g.printf("}\n")
+				g.printf("return %sValues[k]\n", name)
+			}
+			g.printf("}\n")
+		}
+		return nil
+	}
+}
+
+// PrintLabels adds a method to translate the enum in the languages of its labels and another one to parse them.
+// All labels are concatenated in one string, with the indexes of the labels of each language.
+// A missing translation falls back on the base language, then on the first one.
+func PrintLabels(enumType string) Configurator {
+	return func(g *Generator) error {
+		if len(g.langs) == 0 {
+			return nil
+		}
+		enums := namedEnums(g.enums)
+		err := checkLabels(enums, g.langs)
+		if err != nil {
+			return err
+		}
+		var (
+			buf     = new(bytes.Buffer)
+			indexes = make([]string, len(g.langs))
+		)
+		for k, lang := range g.langs {
+			pos := []string{strconv.Itoa(buf.Len())}
+			for _, e := range enums {
+				_, _ = buf.WriteString(e.Labels[lang])
+				pos = append(pos, strconv.Itoa(buf.Len()))
+			}
+			indexes[k] = strings.Join(pos, ", ")
+		}
+		g.printf("\n")
+		g.printf("const _%sLabels = %q\n", enumType, buf.String())
+		g.printf("\n")
+		g.printf("var _%sLabelIndexes = map[string][%d]uint%d{\n", enumType, len(enums)+1, unsignedSize(buf.Len()))
+		for k, lang := range g.langs {
+			g.printf("%q: {%s},\n", lang, indexes[k])
+		}
+		g.printf("}\n")
+		g.printf("\n")
+		g.printf("var _%sLabelValues = [...]%s{\n", enumType, enumType)
+		for _, e := range enums {
+			g.printf("%s,\n", e.Text)
+		}
+		g.printf("}\n")
+
+		g.printf("\n")
+		g.printf("func labelIndex%[1]s(%[2]s %[1]s) int {\n", enumType, shortName)
+		g.printf("switch %s {\n", shortName)
+		for k, e := range enums {
+			g.printf("case %s:\n", e.Text)
+			g.printf("return %d\n", k)
+		}
+		g.printf("default:\n")
+		g.printf("return -1\n")
+		g.printf("}\n")
+		g.printf("}\n")
+
+		g.printf("\n")
+		g.printf("func label%s(lang string, k int) string {\n", enumType)
+		g.printf("p, ok := _%sLabelIndexes[lang]\n", enumType)
+		g.printf("if !ok {\n")
+		g.printf(returnEmpty)
+		g.printf("}\n")
+		g.printf("return _%[1]sLabels[p[k]:p[k+1]]\n", enumType)
+		g.printf("}\n")
+
+		// Languages by order of preference.
+		g.printf("\n")
+		g.printf("func labelLangs%s(lang string) []string {\n", enumType)
+		g.printf("lang = strings.ToLower(strings.ReplaceAll(lang, \"_\", \"-\"))\n")
+		g.printf("if i := strings.Index(lang, \"-\"); i > 0 {\n")
+		g.printf("return []string{lang, lang[:i], %q}\n", g.langs[0])
+		g.printf("}\n")
+		g.printf("return []string{lang, %q}\n", g.langs[0])
+		g.printf("}\n")
+
+		g.printf("\n")
+		g.printf("// Label returns the label of the %s in the given language, like \"%s\".\n", enumType, g.langs[0])
+		g.printf("// Without translation, it falls back on its base language, then on the %q one.\n", g.langs[0])
+		g.printf("func (%s %s) Label(lang string) string {\n", shortName, enumType)
+		g.printf("k := labelIndex%s(%s)\n", enumType, shortName)
+		g.printf("if k < 0 {\n")
+		g.printf(returnEmpty)
+		g.printf("}\n")
+		g.printf("for _, l := range labelLangs%s(lang) {\n", enumType)
+		g.printf("if %s := label%s(l, k); %s != \"\" {\n", strName, enumType, strName)
+		g.printf("return %s\n", strName)
+		g.printf("}\n")
+		g.printf("}\n")
+		g.printf(returnEmpty)
+		g.printf("}\n")
+
+		g.printf("\n")
+		g.printf("// ParseLabel parses the label of a %s in the given language, with the fallbacks of Label.\n", enumType)
+		g.printf("func (%s *%s) ParseLabel(lang, %s string) error {\n", shortName, enumType, strName)
+		g.printf("for _, l := range labelLangs%s(lang) {\n", enumType)
+		g.printf("for k, %s2 := range _%sLabelValues {\n", shortName, enumType)
+		g.printf("if %s == \"\" || label%s(l, k) != %s {\n", strName, enumType, strName)
+		g.printf("continue\n")
+		g.printf("}\n")
+		g.printf("%s", g.deprecationCheck(enumType, shortName+"2"))
+		g.printf("*%s = %s2\n", shortName, shortName)
+		g.printf("return nil\n")
+		g.printf("}\n")
+		g.printf("}\n")
+		if g.decoding == OpenDecoding && g.unknown != "" {
+			g.printf("*%s = %s\n", shortName, g.unknown)
+			g.printf("return nil\n")
+		} else {
+			g.printf("return %s\n", g.invalidErr(enumType, "unknown label %q", strName))
+		}
+		g.printf("}\n")
+
+		return nil
+	}
+}
+
+// PrintLookup adds a private method dedicated to get if exists the name of a constant with ok at true.
+// Otherwise, a standard failover name with ok at false are returned.
+func PrintLookup(enumType string, enumKind Kind) Configurator {
+	return func(g *Generator) error {
+		switch g.mode() {
+		case ArrayLookup:
+			return g.basicString(enumType, enumKind)
+		case RunsLookup:
+			return g.runsString(enumType, enumKind)
+		case SwitchLookup:
+			return g.averageString(enumType)
+		default:
+			return g.advanceString(enumType)
+		}
+	}
+}
+
+// PrintTextMarshaler adds methods to marshal and unmarshal the enum String value as a text.
+func PrintTextMarshaler(format string, enumType string) Configurator {
+	return func(g *Generator) error {
+		// encoding.TextMarshaler
+		g.printf("\n")
+		g.printf("// AppendText implements the encoding.TextAppender interface.\n")
+		g.printf("func (%s %s) AppendText(b []byte) ([]byte, error) {\n", shortName, enumType)
+		g.printf("return append(b, %s.String()...), nil\n", shortName)
+		g.printf("}\n")
+
+		g.printf("\n")
+		g.printf("// MarshalText implements the encoding.TextMarshaler interface.\n")
+		g.printf("func (%s %s) MarshalText() (text []byte, err error) {\n", shortName, enumType)
+		g.printf("return %s.AppendText(nil)\n", shortName)
+		g.printf("}\n")
+
+		// Texts sorted to be parsed with a binary search, without any map to build.
+		keys, texts, err := textTable(g.enums, format)
+		if err != nil {
+			return err
+		}
+		var (
+			buf = new(bytes.Buffer)
+			pos = make([]string, 0, len(keys)+1)
+		)
+		pos = append(pos, zero)
+		for _, s := range keys {
+			_, _ = buf.WriteString(s)
+			pos = append(pos, strconv.Itoa(buf.Len()))
+		}
+		g.printf("\n")
+		g.printf("const _%sTexts = %q\n", enumType, buf.String())
+		g.printf("\n")
+		g.printf("var _%sTextIndexes = [...]uint%d{%s}\n", enumType, unsignedSize(buf.Len()), strings.Join(pos, ", "))
+		g.printf("\n")
+		g.printf("var _%sTextValues = [...]%s{\n", enumType, enumType)
+		for _, s := range keys {
+			g.printf("%s,\n", texts[s].Text)
+		}
+		g.printf("}\n")
+
+		g.printf("\n")
+		g.printf("func parse%[1]s(text []byte) (%[2]s %[1]s, ok bool) {\n", enumType, shortName)
+		g.printf("i, j := 0, len(_%sTextValues)\n", enumType)
+		g.printf("for i < j {\n")
+		g.printf("h := int(uint(i+j) >> 1)\n")
+		g.printf("if _%[1]sTexts[_%[1]sTextIndexes[h]:_%[1]sTextIndexes[h+1]] < string(text) {\n", enumType)
+		g.printf("i = h + 1\n")
+		g.printf("} else {\n")
+		g.printf("j = h\n")
+		g.printf("}\n")
+		g.printf("}\n")
+		g.printf("if i < len(_%[1]sTextValues) && _%[1]sTexts[_%[1]sTextIndexes[i]:_%[1]sTextIndexes[i+1]] == string(text) {\n", enumType)
+		g.printf("return _%sTextValues[i], true\n", enumType)
+		g.printf("}\n")
+		g.printf("return %s, false\n", shortName)
+		g.printf("}\n")
+
+		// encoding.TextUnmarshaler
+		g.printf("\n")
+		g.printf("// UnmarshalText implements the encoding.TextUnmarshaler interface.\n")
+		g.printf("func (e *%s) UnmarshalText(text []byte) error{\n", enumType)
+		g.printf("%s2, ok := parse%s(text)\n", shortName, enumType)
+		g.printf("if !ok {\n")
+		if stmt := g.unknownName(enumType, "text"); stmt != "" {
+			g.printf("%s", stmt)
+		} else {
+			g.printf("return %s\n", g.invalidErr(enumType, "unknown %q", "text"))
+		}
+		g.printf("}\n")
+		g.printf("%s", g.deprecationCheck(enumType, shortName+"2"))
+		g.printf("*%s = %s2\n", shortName, shortName)
+		g.printf("return nil\n")
+		g.printf("}\n")
+
+		return nil
+	}
+}
+
+// PrintXMLMarshaler adds methods to marshal and unmarshal the enum value as XML data.
+func PrintXMLMarshaler(enumType string, enumKind Kind) Configurator {
+	return func(g *Generator) error {
+		// json.Marshaler
+		g.printf("\n")
+		g.printf("// MarshalXML implements the xml.Marshaler interface.\n")
+		g.printf("func (e %s) MarshalXML(x *xml.Encoder, start xml.StartElement) error {\n", enumType)
+		g.printf("return x.EncodeElement(%s, start)", strConvFormat(enumKind))
+		g.printf("}\n")
+
+		// json.Unmarshaler
+		g.printf("// UnmarshalXML implements the xml.Unmarshaler interface.\n")
+		g.printf("func (e *%s) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error{\n", enumType)
+		g.printf("var (\n")
+		g.printf("s string\n")
+		g.printf("err = d.DecodeElement(&s, &start)\n")
+		g.printf(")\n")
+		g.printf("if err != nil {\n")
+		g.printf("%s", g.expectsErr(enumType, enumKind, strName))
+		g.printf("}\n")
+		g.printf(strConvParse(enumType, enumKind, g.decodeCheck, g.expectsErr(enumType, enumKind, strName)))
+		g.printf("return nil\n")
+		g.printf("}\n")
+
+		return nil
+	}
+}
+
+// PrintYAMLMarshaler adds methods to marshal and unmarshal the enum String value as YAML data.
+// By default, the unmarshaler uses the yaml.v2 signature, also supported by yaml.v3 without any import.
+// With node enabled, it uses the yaml.v3 node signature instead to report the line of an unknown value.
+func PrintYAMLMarshaler(enumType string, node bool) Configurator {
+	return func(g *Generator) error {
+		// yaml.Marshaler
+		g.printf("\n")
+		g.printf("// MarshalYAML implements the yaml.Marshaler interface.\n")
+		g.printf("func (%s %s) MarshalYAML() (interface{}, error) {\n", shortName, enumType)
+		g.printf("if _, ok := lookup%s(%s); !ok {\n", enumType, shortName)
+		g.printf("return nil, %s\n", g.invalidErr(enumType, "unknown %v", shortName))
+		g.printf("}\n")
+		g.printf("return %s.String(), nil\n", shortName)
+		g.printf("}\n")
+
+		// yaml.Unmarshaler
+		g.printf("\n")
+		g.printf("// UnmarshalYAML implements the yaml.Unmarshaler interface.\n")
+		if node {
+			g.printf("func (%s *%s) UnmarshalYAML(value *yaml.Node) error {\n", shortName, enumType)
+			g.printf("if value.Kind != yaml.ScalarNode {\n")
+			if g.nofmt {
+				g.printf(
+					"return invalid%sError(\"line \" + strconv.Itoa(value.Line) + \": expects a scalar but got \" + value.Tag)\n",
+					enumType,
+				)
+			} else {
+				g.printf(
+					"return fmt.Errorf(\"line %%d: %%w: expects a scalar but got %%s\", value.Line, ErrInvalid%s, value.Tag)\n",
+					enumType,
+				)
+			}
+			g.printf("}\n")
+			g.printf("err := %s.UnmarshalText([]byte(value.Value))\n", shortName)
+			if g.nofmt {
+				g.printf("if err, ok := err.(invalid%sError); ok {\n", enumType)
+				g.printf("return invalid%sError(\"line \" + strconv.Itoa(value.Line) + \": \" + string(err))\n", enumType)
+				g.printf("}\n")
+				g.printf("return err\n")
+				g.printf("}\n")
+				return nil
+			}
+			g.printf("if err != nil {\n")
+			g.printf("return fmt.Errorf(\"line %%d: %%w\", value.Line, err)\n")
+			g.printf("}\n")
+			g.printf("return nil\n")
+			g.printf("}\n")
+			return nil
+		}
+		g.printf("func (%s *%s) UnmarshalYAML(unmarshal func(interface{}) error) error {\n", shortName, enumType)
+		g.printf("var %s string\n", strName)
+		g.printf("err := unmarshal(&%s)\n", strName)
+		g.printf("if err != nil {\n")
+		if g.nofmt {
+			g.printf("return invalid%sError(\"expects a scalar: \" + err.Error())\n", enumType)
+		} else {
+			g.printf("return fmt.Errorf(\"%%w: expects a scalar: %%v\", ErrInvalid%s, err)\n", enumType)
+		}
+		g.printf("}\n")
+		g.printf("return %s.UnmarshalText([]byte(%s))\n", shortName, strName)
+		g.printf("}\n")
+
+		return nil
+	}
+}
+
+// WriteGraphQLSchema tries to write the GraphQL schema file declaring the enum type.
+// Each enum value is named as its text representation, as returned by the GraphQL marshaler.
+// The deprecated values get the @deprecated directive with their message as reason.
+func WriteGraphQLSchema(filename, format, enumType string, args []string) Configurator {
+	return func(g *Generator) error {
+		if filename == "" {
+			return fmt.Errorf("graphql schema filename: %w", ErrMissing)
+		}
+		var buf bytes.Buffer
+		_, _ = fmt.Fprintf(&buf, "# Code generated by %q; DO NOT EDIT.\n", generatedBy(args))
+		_, _ = fmt.Fprintf(&buf, "\n")
+		_, _ = fmt.Fprintf(&buf, "enum %s {\n", enumType)
+		for k, e := range g.enums {
+			if e.Text == unnamed {
+				continue
+			}
+			s, err := enumText(e, format)
+			if err != nil {
+				return fmt.Errorf("enum value #%d: %w", k, err)
+			}
+			if !isGraphQLName(s) {
+				return fmt.Errorf("graphql enum value %q: %w", s, ErrInvalid)
+			}
+			if e.Deprecated != "" {
+				_, _ = fmt.Fprintf(&buf, "  %s @deprecated(reason: %s)\n", s, graphQLString(e.Deprecated))
+				continue
+			}
+			_, _ = fmt.Fprintf(&buf, "  %s\n", s)
+		}
+		_, _ = fmt.Fprintf(&buf, "}\n")
+		err := ioutil.WriteFile(filename, buf.Bytes(), 0600)
+		if err != nil {
+			return fmt.Errorf("graphql schema: %w", err)
+		}
+		return nil
+	}
+}
+
+// WriteFile tries to write the go file.
+func WriteFile(filename string) Configurator {
+	return func(g *Generator) error {
+		if filename == "" {
+			return fmt.Errorf("destination filename: %w", ErrMissing)
+		}
+		if g.err != nil {
+			return g.err
+		}
+		src, err := format.Source(g.buf.Bytes())
+		if err != nil {
+			// Allows the user to compile the output to see the error.
+			src = g.buf.Bytes()
+		}
+		wrr := os.MkdirAll(filepath.Dir(filename), 0750)
+		if wrr == nil {
+			wrr = ioutil.WriteFile(filename, src, 0600)
+		}
+		if wrr != nil {
+			return fmt.Errorf("destination: %w", wrr)
+		}
+		if err != nil {
+			return fmt.Errorf("go format failed: %w", err)
+		}
+		return nil
+	}
+}
+
+// CheckSource checks that the generated source is a valid Go code, without writing it.
+func CheckSource() Configurator {
+	return func(g *Generator) error {
+		if g.err != nil {
+			return g.err
+		}
+		_, err := format.Source(g.buf.Bytes())
+		if err != nil {
+			return fmt.Errorf("go format failed: %w", err)
+		}
+		return nil
+	}
+}
+
+// WriteList writes in w the constants, one by line with their value, their text and their state.
+func WriteList(w io.Writer) Configurator {
+	return func(g *Generator) error {
+		tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+		_, _ = fmt.Fprintln(tw, "CONSTANT\tVALUE\tTEXT\tSTATE")
+		for _, e := range g.enums {
+			var state string
+			switch {
+			case e.Retired:
+				state = "retired"
+			case e.Deprecated != "":
+				state = "deprecated"
+			}
+			_, _ = fmt.Fprintf(tw, "%s\t%s\t%s\t%s\n", e.Text, e.Value, e.RawText, state)
+		}
+		err := tw.Flush()
+		if err != nil {
+			return fmt.Errorf("list: %w", err)
+		}
+		return nil
+	}
+}
+
+// WriteTestFile tries to write the go test file checking the methods enabled by the settings:
+// round-trips of each constant through its marshalers, rejection of the values around the known ones,
+// bitwise operations and fuzzing of the text parser.
+func WriteTestFile(s Settings, args []string) Configurator {
+	return func(g *Generator) error {
+		if s == nil {
+			return fmt.Errorf("settings: %w", ErrMissing)
+		}
+		var (
+			t        = &Generator{enums: g.enums, decoding: g.decoding, unknown: g.unknown, deprecation: g.deprecation}
+			enumType = s.TypeName()
+			dep      = map[string]struct{}{"testing": {}}
+		)
+		if s.Validator() {
+			if edges, _ := edgeValues(g.enums, s.Bitmask()); len(edges) > 0 {
+				dep["errors"] = struct{}{}
+			}
+		}
+		if s.JSONMarshaler() {
+			dep["encoding/json"] = struct{}{}
+		}
+		if s.XMLMarshaler() {
+			dep["encoding/xml"] = struct{}{}
+		}
+		t.printf("//go:build go1.18\n")
+		t.printf("// +build go1.18\n")
+		t.printf("\n")
+		err := PrintHeader(s.PackageName(), args, dep)(t)
+		if err != nil {
+			return err
+		}
+		t.printf("\n")
+		t.printf("var _%sTestValues = []%s{\n", enumType, enumType)
+		for _, e := range g.enums {
+			// Deprecated constants can not be decoded when they are rejected.
+			if e.Text != unnamed && (e.Deprecated == "" || g.deprecation != RejectDeprecated) {
+				t.printf("%s,\n", e.Text)
+			}
+		}
+		t.printf("}\n")
+
+		if s.TextMarshaler() {
+			t.printTestRoundTrip(enumType, "String", "", "%[1]s2.UnmarshalText([]byte(%[1]s.String()))")
+			t.printTestRoundTrip(enumType, "MarshalText", "%[1]s.MarshalText()", "%[1]s2.UnmarshalText(%[2]s)")
+		}
+		if s.JSONMarshaler() {
+			t.printTestRoundTrip(enumType, "MarshalJSON", "json.Marshal(%[1]s)", "json.Unmarshal(%[2]s, &%[1]s2)")
+		}
+		if s.XMLMarshaler() {
+			t.printTestRoundTrip(enumType, "MarshalXML", "xml.Marshal(%[1]s)", "xml.Unmarshal(%[2]s, &%[1]s2)")
+		}
+		if s.BinaryMarshaler() {
+			t.printTestRoundTrip(enumType, "MarshalBinary", "%[1]s.MarshalBinary()", "%[1]s2.UnmarshalBinary(%[2]s)")
+		}
+		if s.Validator() {
+			err = t.printTestValidator(enumType, s.Bitmask())
+			if err != nil {
+				return err
+			}
+		}
+		if s.Values() {
+			t.printTestValues(enumType)
+		}
+		if s.Set() {
+			t.printTestSet(enumType, s.JSONMarshaler())
+		}
+		if s.Transitions() != nil {
+			t.printTestTransitions(enumType)
+		}
+		if s.Ordered() {
+			t.printTestOrdered(enumType)
+		}
+		if len(enumGroups(g.enums)) > 0 {
+			t.printTestGroups(enumType)
+		}
+		if len(g.langs) > 0 {
+			t.langs = g.langs
+			t.printTestLabels(enumType)
+		}
+		if s.Bitmask() {
+			t.printTestBitmask(enumType)
+		}
+		if s.TextMarshaler() {
+			err = t.printTestAliases(s.StringFormater(), enumType)
+			if err != nil {
+				return err
+			}
+			t.printFuzzText(enumType)
+			t.printBenchText(enumType)
+			t.printBenchAppend(enumType, "AppendText")
+		}
+		if s.JSONMarshaler() {
+			t.printBenchAppend(enumType, "AppendJSON")
+		}
+		return WriteFile(s.TestFilename())(t)
+	}
+}
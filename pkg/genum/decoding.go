@@ -0,0 +1,18 @@
+// Copyright (c) 2021 Hervé Gouchet. All rights reserved.
+// Use of this source code is governed by the MIT License
+// that can be found in the LICENSE file.
+
+package genum
+
+// Decoding represents how the decoders handle the unknown values.
+type Decoding uint8
+
+// List of supported decoding semantics.
+const (
+	// DefaultDecoding rejects the unknown names and binary data, but accepts any number.
+	DefaultDecoding Decoding = iota
+	// ClosedDecoding rejects any unknown value.
+	ClosedDecoding
+	// OpenDecoding accepts any unknown value: decoded as the unknown constant if any, kept as is otherwise.
+	OpenDecoding
+)
@@ -0,0 +1,41 @@
+// Copyright (c) 2021 Hervé Gouchet. All rights reserved.
+// Use of this source code is governed by the MIT License
+// that can be found in the LICENSE file.
+
+package genum
+
+import "strings"
+
+// Deprecation represents how the parsers handle the deprecated values.
+type Deprecation uint8
+
+// List of supported deprecation policies.
+const (
+	// AcceptDeprecated parses the deprecated values as any other ones.
+	AcceptDeprecated Deprecation = iota
+	// WarnDeprecated parses the deprecated values but calls a handler, logging them by default.
+	WarnDeprecated
+	// RejectDeprecated returns an error on parsing a deprecated value.
+	RejectDeprecated
+)
+
+var deprecationNames = [...]string{"accept", "warn", "reject"}
+
+// DeprecationNamed converts s to a Deprecation.
+func DeprecationNamed(s string) Deprecation {
+	s = strings.ToLower(s)
+	for k, v := range deprecationNames {
+		if v == s {
+			return Deprecation(k)
+		}
+	}
+	return AcceptDeprecated
+}
+
+// String implements the fmt.Stringer interface.
+func (d Deprecation) String() string {
+	if int(d) < len(deprecationNames) {
+		return deprecationNames[d]
+	}
+	return deprecationNames[AcceptDeprecated]
+}
@@ -0,0 +1,37 @@
+// Copyright (c) 2021 Hervé Gouchet. All rights reserved.
+// Use of this source code is governed by the MIT License
+// that can be found in the LICENSE file.
+
+package genum_test
+
+import (
+	"testing"
+
+	"github.com/matryer/is"
+	"github.com/rvflash/genum/pkg/genum"
+)
+
+func TestDeprecationNamed(t *testing.T) {
+	t.Parallel()
+	var (
+		are = is.New(t)
+		dt  = map[string]struct {
+			in  string
+			out genum.Deprecation
+		}{
+			"Default": {out: genum.AcceptDeprecated},
+			"Unknown": {in: "ignore", out: genum.AcceptDeprecated},
+			"WARN":    {in: "WARN", out: genum.WarnDeprecated},
+			"reject":  {in: "reject", out: genum.RejectDeprecated},
+		}
+	)
+	for name, tt := range dt {
+		tt := tt
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			out := genum.DeprecationNamed(tt.in)
+			are.Equal(out, tt.out)
+			are.Equal(genum.DeprecationNamed(out.String()), out) // mismatch round-trip
+		})
+	}
+}
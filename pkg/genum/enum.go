@@ -11,12 +11,20 @@ import (
 
 // Enum represents an Enum.
 type Enum struct {
-	Iota    string
-	Kind    Kind
-	Text    string
-	RawText string
-	Type    string
-	Value   string
+	Iota       string
+	Kind       Kind
+	Text       string
+	RawText    string
+	Type       string
+	Value      string
+	Deprecated string
+	Retired    bool
+	Aliases    []string
+	Labels     map[string]string
+	Metadata   map[string]string
+	Group      string
+	// Separated is true if blank lines separate its record from the previous one in the source.
+	Separated bool
 }
 
 // Format formats the constant regarding to its context (iota, position, etc.)
@@ -37,7 +45,7 @@ func (e Enum) Format(pos int, useIota, commented bool) string {
 		if e.Iota != "" {
 			p = append(p, e.Type, "=", e.Iota)
 		}
-	} else if e.Text != unnamed || pos == 0 {
+	} else if e.Text != unnamed || e.Retired || pos == 0 {
 		p = append(p, e.Type, "=", e.Value)
 	}
 	if commented {
@@ -29,6 +29,7 @@ func TestEnum_Format(t *testing.T) {
 			"Empty":     {in: genum.Enum{Text: "_", Type: "Hi"}},
 			"Untyped":   {in: genum.Enum{Text: "hi", Value: "0"}},
 			"Unnamed":   {in: genum.Enum{Text: "_", Type: "Hi", Value: "0"}, out: "_ Hi = 0\n"},
+			"Retired":   {in: genum.Enum{Text: "_", Type: "Hi", Value: "3", Retired: true}, pos: 2, out: "_ Hi = 3\n"},
 			"First one": {in: genum.Enum{Text: "Hello", Type: "Hi", Value: "0"}, out: "Hello Hi = 0\n"},
 			"Iota": {
 				in:      genum.Enum{Text: "Hello", Type: "Hi", Value: "0", Iota: "iota + 2"},
@@ -14,4 +14,6 @@ func (e errGenum) Error() string {
 const (
 	// ErrMissing is returned when a data is missing.
 	ErrMissing = errGenum("missing data")
+	// ErrInvalid is returned when a data is not valid.
+	ErrInvalid = errGenum("invalid data")
 )
@@ -7,7 +7,10 @@ package genum
 import (
 	"bytes"
 	"fmt"
+	"io"
+	"path/filepath"
 	"strconv"
+	"strings"
 )
 
 const (
@@ -17,12 +20,22 @@ const (
 	srcName        = "data"
 	increment      = "iota"
 	returnNotFound = "return \"\", false\n"
-	returnErr      = "return fmt.Errorf(\"%s expects %s but got %%s\", %s)\n"
+	returnEmpty    = "return \"\"\n"
 	lookupFunc     = "func lookup%[1]s(%[2]s %[1]s) (%[3]s string, ok bool) {\n"
 	unnamed        = "_"
 	zero           = "0"
 
-	maxHumanScale = 10
+	// maxRuns is the maximum number of runs of consecutive values looked up with arrays.
+	maxRuns = 10
+	// maxArraySpan is the maximum number of values, known or not, looked up with one array.
+	maxArraySpan = 1 << 16
+	// maxBitsetSpan is the maximum number of values, known or not, held by the bitset of a set.
+	maxBitsetSpan = 1 << 8
+	// setSuffix is appended to the enum type to name its set type.
+	setSuffix = "Set"
+	// groupSuffix is appended to the enum type to name its group type.
+	groupSuffix = "Group"
+	wordSize    = 64
 )
 
 // Layout returns the generation configuration based on the given settings.
@@ -30,21 +43,60 @@ func Layout(s Settings, args []string) []Configurator {
 	if s == nil {
 		return nil
 	}
-	var cnf []Configurator
+	dst := s.DstFilename()
+	cnf := append(layout(s, args), CheckPackage(filepath.Dir(dst), filepath.Base(dst)))
+	if s.GraphQLSchema() != "" {
+		cnf = append(cnf, WriteGraphQLSchema(s.GraphQLSchema(), s.StringFormater(), s.TypeName(), args))
+	}
+	if s.TestFilename() != "" {
+		cnf = append(cnf, WriteTestFile(s, args))
+	}
+	return append(cnf, WriteFile(dst))
+}
+
+// Check returns the configuration checking the generation based on the given settings, without writing any file.
+func Check(s Settings, args []string) []Configurator {
+	if s == nil {
+		return nil
+	}
+	return append(layout(s, args), CheckSource())
+}
+
+// List returns the configuration listing the constants parsed with the given settings in w.
+func List(s Settings, w io.Writer) []Configurator {
+	if s == nil {
+		return nil
+	}
+	return append(parse(s), WriteList(w))
+}
+
+// parse returns the configuration parsing the constants based on the given settings.
+func parse(s Settings) []Configurator {
+	cnf := []Configurator{
+		HandleDeprecated(s.Deprecation()), HandleLookup(s.Lookup(), s.SwitchMax()), HandleFmt(s.NoFmt()),
+	}
 	if s.Bitmask() {
-		cnf = append(cnf, ParseBitmask(s.SrcFile(), s.TypeName(), s.JoinPrefix(), s.TrimPrefix()))
-	} else {
-		cnf = append(cnf, ParseEnums(s.SrcFile(), s.TypeName(), s.TypeKind(), s.JoinPrefix(), s.TrimPrefix(), s.Iota()))
+		return append(cnf, ParseBitmask(s.SrcFile(), s.TypeName(), s.JoinPrefix(), s.TrimPrefix(), s.Header()))
 	}
-	cnf = append(
-		cnf,
+	return append(cnf, ParseEnums(
+		s.SrcFile(), s.TypeName(), s.TypeKind(), s.JoinPrefix(), s.TrimPrefix(), s.Iota(), s.Header(),
+	))
+}
+
+// layout returns the configuration generating the source in memory based on the given settings.
+func layout(s Settings, args []string) []Configurator {
+	cnf := append(
+		parse(s),
+		HandleDecoding(s.Decoding(), s.Unknown()),
 		PrintHeader(s.PackageName(), args, dependencies(s)),
 		PrintEnums(s.TypeName(), s.Iota(), s.Commented()),
+		PrintDeprecated(s.TypeName()),
+		PrintInvalidError(s.TypeName(), hasDecoder(s)),
 	)
 	if s.Bitmask() {
 		cnf = append(cnf, PrintBitmask(s.TypeName()))
 	}
-	if s.Stringer() || s.Validator() {
+	if s.Stringer() || s.Validator() || s.BinaryMarshaler() || s.Decoding() != DefaultDecoding {
 		cnf = append(cnf, PrintLookup(s.TypeName(), s.TypeKind()))
 	}
 	if s.Stringer() {
@@ -53,13 +105,47 @@ func Layout(s Settings, args []string) []Configurator {
 	if s.Validator() {
 		cnf = append(cnf, PrintValidator(s.TypeName()))
 	}
+	if s.Values() {
+		cnf = append(cnf, PrintValues(s.TypeName()))
+	}
+	if s.Set() {
+		cnf = append(cnf, PrintSet(s.TypeName(), s.TypeKind()))
+	}
+	if s.Transitions() != nil {
+		cnf = append(cnf, ParseTransitions(s.Transitions()), PrintTransitions(s.TypeName()))
+	}
+	if s.Ordered() {
+		cnf = append(cnf, PrintOrdered(s.TypeName(), s.Order()))
+	}
 	if s.JSONMarshaler() {
 		cnf = append(cnf, PrintJSONMarshaler(s.TypeName(), s.TypeKind()))
+		if s.Set() {
+			cnf = append(cnf, PrintSetJSONMarshaler(s.TypeName()))
+		}
+	}
+	if s.XMLMarshaler() {
+		cnf = append(cnf, PrintXMLMarshaler(s.TypeName(), s.TypeKind()))
 	}
 	if s.TextMarshaler() {
 		cnf = append(cnf, PrintTextMarshaler(s.StringFormater(), s.TypeName()))
 	}
-	return append(cnf, WriteFile(s.DstFilename()))
+	cnf = append(cnf, PrintLabels(s.TypeName()), PrintMetadata(s.TypeName()), PrintGroups(s.TypeName()))
+	if s.FlagValue() {
+		cnf = append(cnf, PrintFlagValue(s.TypeName(), s.Bitmask()))
+	}
+	if s.GraphQLMarshaler() {
+		cnf = append(cnf, PrintGraphQLMarshaler(s.TypeName()))
+	}
+	if s.BinaryMarshaler() {
+		cnf = append(cnf, PrintBinaryMarshaler(s.TypeName(), s.Bitmask()))
+	}
+	if s.YAMLMarshaler() {
+		cnf = append(cnf, PrintYAMLMarshaler(s.TypeName(), s.YAMLNode()))
+	}
+	if s.Register() {
+		cnf = append(cnf, PrintRegister(s.StringFormater(), s.TypeName(), s.TypeKind()))
+	}
+	return cnf
 }
 
 // Generate generates the enum file based on these options.
@@ -76,18 +162,29 @@ func Generate(opts ...Configurator) (err error) {
 
 // Generator represents an enum generator.
 type Generator struct {
-	enums []Enum
-	basic bool
-	buf   bytes.Buffer
-	err   error
+	enums       []Enum
+	langs       []string
+	lookup      Lookup
+	switchMax   int
+	bitmask     bool
+	decoding    Decoding
+	unknown     string
+	deprecation Deprecation
+	nofmt       bool
+	transitions map[string][]string
+	metadata    []metaColumn
+	buf         bytes.Buffer
+	err         error
 }
 
 func (g *Generator) advanceString(enumType string) error {
 	// Variable with enums names.
 	g.printf("\n")
 	g.printf("var _%sNames = map[%s]string{\n", enumType, enumType)
-	for _, e := range g.enums {
-		g.printf("%s: %q,\n", e.Text, e.RawText)
+	for _, e := range distinctEnums(g.enums) {
+		if e.Text != unnamed {
+			g.printf("%s: %q,\n", e.Text, e.RawText)
+		}
 	}
 	g.printf("}\n")
 
@@ -106,7 +203,10 @@ func (g *Generator) averageString(enumType string) error {
 	g.printf("\n")
 	g.printf(lookupFunc, enumType, shortName, strName)
 	g.printf("switch %s {\n", shortName)
-	for _, e := range g.enums {
+	for _, e := range distinctEnums(g.enums) {
+		if e.Text == unnamed {
+			continue
+		}
 		g.printf("case %s:\n", e.Text)
 		g.printf("return %q, true\n", e.RawText)
 	}
@@ -119,13 +219,32 @@ func (g *Generator) averageString(enumType string) error {
 }
 
 func (g *Generator) basicString(enumType string, enumKind Kind) error {
+	runs := enumRuns(g.enums)
+	if len(runs) == 0 {
+		return fmt.Errorf("array lookup: integer values: %w", ErrInvalid)
+	}
 	var (
-		buf = new(bytes.Buffer)
-		pos = make([]int, len(g.enums))
+		first = runs[0][0]
+		last  = runs[len(runs)-1][len(runs[len(runs)-1])-1]
 	)
-	for p, e := range g.enums {
-		_, _ = buf.WriteString(e.RawText)
-		pos[p] = buf.Len()
+	if len(runs) > 1 && enumOrder(last)-enumOrder(first) >= maxArraySpan {
+		return fmt.Errorf("array lookup: from %s to %s: %w", first.Value, last.Value, ErrInvalid)
+	}
+	var (
+		buf  = new(bytes.Buffer)
+		pos  []int
+		prev = enumOrder(first)
+	)
+	for _, r := range runs {
+		// Unknown values between two runs have an empty name.
+		for i := prev; i < enumOrder(r[0]); i++ {
+			pos = append(pos, buf.Len())
+		}
+		for _, e := range r {
+			_, _ = buf.WriteString(e.RawText)
+			pos = append(pos, buf.Len())
+		}
+		prev = enumOrder(r[len(r)-1]) + 1
 	}
 	// Constant with all enums names concatenated together.
 	g.printf("\n")
@@ -146,40 +265,800 @@ func (g *Generator) basicString(enumType string, enumKind Kind) error {
 	// Lookup method based on a slice of contant names.
 	g.printf("\n")
 	g.printf(lookupFunc, enumType, shortName, strName)
-	var guardRail string
-	if enumKind.IsSigned() && g.enums[0].Value != zero {
-		guardRail = shortName + " < 0 || "
-		g.printf("%s -= %s\n", shortName, g.enums[0].Value)
+	// The offset from the first value is computed on 64 bits to not overflow the kind,
+	// a value before the first one wraps around and is out of range.
+	switch {
+	case first.Value == zero:
+		g.printf("i := uint64(%s)\n", shortName)
+	case enumKind.IsSigned() && strings.HasPrefix(first.Value, "-"):
+		g.printf("i := uint64(int64(%s) - (%s))\n", shortName, first.Value)
+	default:
+		g.printf("i := uint64(%s) - %s\n", shortName, first.Value)
 	}
-	g.printf("if %s%s >= %s(len(_%sIndexes)-1) {\n", guardRail, shortName, enumType, enumType)
+	g.printf("if i >= uint64(len(_%sIndexes)-1) {\n", enumType)
 	g.printf(returnNotFound)
 	g.printf("}\n")
-	g.printf("return _%[1]sNames[_%[1]sIndexes[%[2]s]:_%[1]sIndexes[%[2]s+1]], true\n", enumType, shortName)
+	if len(runs) > 1 {
+		// Unknown values between runs have an empty name.
+		g.printf("%[2]s = _%[1]sNames[_%[1]sIndexes[i]:_%[1]sIndexes[i+1]]\n", enumType, strName)
+		g.printf("return %[1]s, %[1]s != \"\"\n", strName)
+	} else {
+		g.printf("return _%[1]sNames[_%[1]sIndexes[i]:_%[1]sIndexes[i+1]], true\n", enumType)
+	}
 	g.printf("}\n")
 
 	return nil
 }
 
-type mode uint8
+func (g *Generator) runsString(enumType string, enumKind Kind) error {
+	runs := enumRuns(g.enums)
+	if len(runs) == 0 {
+		return fmt.Errorf("runs lookup: integer values: %w", ErrInvalid)
+	}
+	var (
+		buf     = new(bytes.Buffer)
+		indexes = make([]string, len(runs))
+	)
+	for k, r := range runs {
+		pos := []string{strconv.Itoa(buf.Len())}
+		for _, e := range r {
+			_, _ = buf.WriteString(e.RawText)
+			pos = append(pos, strconv.Itoa(buf.Len()))
+		}
+		indexes[k] = strings.Join(pos, ", ")
+	}
+	// Constant with all enums names concatenated together.
+	g.printf("\n")
+	g.printf("const _%sNames = %q\n", enumType, buf.String())
 
-const (
-	// basic is the default mode, where enums are integers.
-	basic mode = iota
-	// average is used when the list of enums is human scale with integers.
-	average
-	// advance is used to handle enums not matching constraints of other modes.
-	advance
-)
+	// Variables with positions of the enums names, by run of consecutive values.
+	g.printf("\n")
+	g.printf("var (\n")
+	for k := range runs {
+		g.printf("_%sIndexes_%d = [...]uint%d{%s}\n", enumType, k, unsignedSize(buf.Len()), indexes[k])
+	}
+	g.printf(")\n")
+
+	// Lookup method based on the run of the value.
+	g.printf("\n")
+	g.printf(lookupFunc, enumType, shortName, strName)
+	g.printf("switch {\n")
+	for k, r := range runs {
+		first, last := r[0].Value, r[len(r)-1].Value
+		if first == last {
+			g.printf("case %s == %s:\n", shortName, first)
+			g.printf("return _%[1]sNames[_%[1]sIndexes_%[2]d[0]:_%[1]sIndexes_%[2]d[1]], true\n", enumType, k)
+			continue
+		}
+		switch {
+		case first == zero && !enumKind.IsSigned():
+			g.printf("case %s <= %s:\n", shortName, last)
+		default:
+			g.printf("case %[2]s <= %[1]s && %[1]s <= %[3]s:\n", shortName, first, last)
+		}
+		index := shortName
+		switch {
+		case enumKind.IsSigned() && strings.HasPrefix(first, "-"):
+			// The offset from a negative value is computed on 64 bits to not overflow the kind.
+			index = "i"
+			g.printf("i := int64(%s) - (%s)\n", shortName, first)
+		case first != zero:
+			g.printf("%s -= %s\n", shortName, first)
+		}
+		g.printf("return _%[1]sNames[_%[1]sIndexes_%[3]d[%[2]s]:_%[1]sIndexes_%[3]d[%[2]s+1]], true\n", enumType, index, k)
+	}
+	g.printf("default:\n")
+	g.printf(returnNotFound)
+	g.printf("}\n")
+	g.printf("}\n")
+
+	return nil
+}
+
+// bitsetSet prints the set type backed by an array of words, with one bit by value from the first known constant.
+func (g *Generator) bitsetSet(setType, enumType string, enumKind Kind, span uint64) {
+	first := sortedEnums(g.enums)[0].Value
+	g.printf("\n")
+	g.printf("// %s is a set of known %s constants, backed by a bitset.\n", setType, enumType)
+	g.printf("// Its zero value is an empty set ready to use.\n")
+	g.printf("type %s struct {\n", setType)
+	g.printf("bits [%d]uint64\n", (span+wordSize-1)/wordSize)
+	g.printf("}\n")
+
+	g.printf("\n")
+	g.printf("// index returns the position of the bit representing the constant.\n")
+	g.printf("func (%s) index(%s %s) uint64 {\n", setType, shortName, enumType)
+	switch {
+	case first == zero:
+		g.printf("return uint64(%s)\n", shortName)
+	case enumKind.IsSigned() && strings.HasPrefix(first, "-"):
+		g.printf("return uint64(int64(%s) - (%s))\n", shortName, first)
+	default:
+		g.printf("return uint64(%s) - %s\n", shortName, first)
+	}
+	g.printf("}\n")
+
+	g.printf("\n")
+	g.printf("// Add adds the known constants to the set, ignoring the other values.\n")
+	g.printf("func (%s *%s) Add(values ...%s) {\n", strName, setType, enumType)
+	g.printf("for _, %s := range values {\n", shortName)
+	g.printf("if %s.IsValid() {\n", shortName)
+	g.printf("i := %s.index(%s)\n", strName, shortName)
+	g.printf("%s.bits[i/%d] |= 1 << (i %% %d)\n", strName, wordSize, wordSize)
+	g.printf("}\n")
+	g.printf("}\n")
+	g.printf("}\n")
+
+	g.printf("\n")
+	g.printf("// Remove removes the values from the set.\n")
+	g.printf("func (%s *%s) Remove(values ...%s) {\n", strName, setType, enumType)
+	g.printf("for _, %s := range values {\n", shortName)
+	g.printf("if %s.IsValid() {\n", shortName)
+	g.printf("i := %s.index(%s)\n", strName, shortName)
+	g.printf("%s.bits[i/%d] &^= 1 << (i %% %d)\n", strName, wordSize, wordSize)
+	g.printf("}\n")
+	g.printf("}\n")
+	g.printf("}\n")
+
+	g.printf("\n")
+	g.printf("// Contains returns true if the value is in the set.\n")
+	g.printf("func (%s %s) Contains(%s %s) bool {\n", strName, setType, shortName, enumType)
+	g.printf("if !%s.IsValid() {\n", shortName)
+	g.printf("return false\n")
+	g.printf("}\n")
+	g.printf("i := %s.index(%s)\n", strName, shortName)
+	g.printf("return %s.bits[i/%d]&(1<<(i%%%d)) != 0\n", strName, wordSize, wordSize)
+	g.printf("}\n")
+
+	g.printf("\n")
+	g.printf("// Len returns the number of constants in the set.\n")
+	g.printf("func (%s %s) Len() int {\n", strName, setType)
+	g.printf("var n int\n")
+	g.printf("for _, w := range %s.bits {\n", strName)
+	g.printf("for ; w != 0; w &= w - 1 {\n")
+	g.printf("n++\n")
+	g.printf("}\n")
+	g.printf("}\n")
+	g.printf("return n\n")
+	g.printf("}\n")
+
+	for _, op := range [...]struct{ name, doc, assign string }{
+		{name: "Union", doc: "in the set or in the other one", assign: "|="},
+		{name: "Intersect", doc: "both in the set and in the other one", assign: "&="},
+	} {
+		g.printf("\n")
+		g.printf("// %s returns a new set with the constants %s.\n", op.name, op.doc)
+		g.printf("func (%s %s) %s(other %s) %s {\n", strName, setType, op.name, setType, setType)
+		g.printf("for k := range %s.bits {\n", strName)
+		g.printf("%s.bits[k] %s other.bits[k]\n", strName, op.assign)
+		g.printf("}\n")
+		g.printf("return %s\n", strName)
+		g.printf("}\n")
+	}
+}
+
+// mapSet prints the set type backed by a map.
+func (g *Generator) mapSet(setType, enumType string) {
+	g.printf("\n")
+	g.printf("// %s is a set of known %s constants.\n", setType, enumType)
+	g.printf("// Its zero value is an empty set ready to use.\n")
+	g.printf("type %s struct {\n", setType)
+	g.printf("m map[%s]struct{}\n", enumType)
+	g.printf("}\n")
+
+	g.printf("\n")
+	g.printf("// Add adds the known constants to the set, ignoring the other values.\n")
+	g.printf("func (%s *%s) Add(values ...%s) {\n", strName, setType, enumType)
+	g.printf("for _, %s := range values {\n", shortName)
+	g.printf("if !%s.IsValid() {\n", shortName)
+	g.printf("continue\n")
+	g.printf("}\n")
+	g.printf("if %s.m == nil {\n", strName)
+	g.printf("%s.m = make(map[%s]struct{})\n", strName, enumType)
+	g.printf("}\n")
+	g.printf("%s.m[%s] = struct{}{}\n", strName, shortName)
+	g.printf("}\n")
+	g.printf("}\n")
+
+	g.printf("\n")
+	g.printf("// Remove removes the values from the set.\n")
+	g.printf("func (%s *%s) Remove(values ...%s) {\n", strName, setType, enumType)
+	g.printf("for _, %s := range values {\n", shortName)
+	g.printf("delete(%s.m, %s)\n", strName, shortName)
+	g.printf("}\n")
+	g.printf("}\n")
+
+	g.printf("\n")
+	g.printf("// Contains returns true if the value is in the set.\n")
+	g.printf("func (%s %s) Contains(%s %s) bool {\n", strName, setType, shortName, enumType)
+	g.printf("_, ok := %s.m[%s]\n", strName, shortName)
+	g.printf("return ok\n")
+	g.printf("}\n")
+
+	g.printf("\n")
+	g.printf("// Len returns the number of constants in the set.\n")
+	g.printf("func (%s %s) Len() int {\n", strName, setType)
+	g.printf("return len(%s.m)\n", strName)
+	g.printf("}\n")
+
+	g.printf("\n")
+	g.printf("// Union returns a new set with the constants in the set or in the other one.\n")
+	g.printf("func (%s %s) Union(other %s) %s {\n", strName, setType, setType, setType)
+	g.printf("res := %s{m: make(map[%s]struct{}, len(%s.m)+len(other.m))}\n", setType, enumType, strName)
+	g.printf("for %s := range %s.m {\n", shortName, strName)
+	g.printf("res.m[%s] = struct{}{}\n", shortName)
+	g.printf("}\n")
+	g.printf("for %s := range other.m {\n", shortName)
+	g.printf("res.m[%s] = struct{}{}\n", shortName)
+	g.printf("}\n")
+	g.printf("return res\n")
+	g.printf("}\n")
+
+	g.printf("\n")
+	g.printf("// Intersect returns a new set with the constants both in the set and in the other one.\n")
+	g.printf("func (%s %s) Intersect(other %s) %s {\n", strName, setType, setType, setType)
+	g.printf("var res %s\n", setType)
+	g.printf("for %s := range %s.m {\n", shortName, strName)
+	g.printf("if other.Contains(%s) {\n", shortName)
+	g.printf("res.Add(%s)\n", shortName)
+	g.printf("}\n")
+	g.printf("}\n")
+	g.printf("return res\n")
+	g.printf("}\n")
+}
+
+// decodeCheck returns the statements handling a decoded enum value in a parser, unknown or deprecated.
+func (g *Generator) decodeCheck(enumType, value string) string {
+	return g.unknownCheck(enumType, value) + g.deprecationCheck(enumType, value)
+}
+
+// unknownCheck returns the statements handling an unknown enum value in a parser, based on the decoding semantics.
+func (g *Generator) unknownCheck(enumType, value string) string {
+	cond := fmt.Sprintf("_, ok := lookup%s(%s); !ok", enumType, value)
+	if g.bitmask {
+		cond = fmt.Sprintf("%s&^%#x != 0", value, bitmaskMask(len(g.enums)))
+	}
+	switch {
+	case g.decoding == ClosedDecoding:
+		return fmt.Sprintf("if %s {\nreturn %s\n}\n", cond, g.invalidErr(enumType, "unknown %v", value))
+	case g.decoding == OpenDecoding && g.unknown != "":
+		return fmt.Sprintf("if %s {\n*%s = %s\nreturn nil\n}\n", cond, shortName, g.unknown)
+	default:
+		return ""
+	}
+}
+
+// unknownName returns the statements handling an unknown name in a parser, based on the decoding semantics.
+// Only an open enum of strings can keep it as is.
+func (g *Generator) unknownName(enumType, name string) string {
+	switch {
+	case g.decoding == OpenDecoding && g.unknown != "":
+		return fmt.Sprintf("*%s = %s\nreturn nil\n", shortName, g.unknown)
+	case g.decoding == OpenDecoding && len(g.enums) > 0 && g.enums[0].Kind == String && !g.bitmask:
+		return fmt.Sprintf("*%s = %s(%s)\nreturn nil\n", shortName, enumType, name)
+	default:
+		return ""
+	}
+}
+
+// deprecationCheck returns the statements handling a deprecated enum value in a parser, based on the generator policy.
+func (g *Generator) deprecationCheck(enumType, value string) string {
+	switch g.deprecation {
+	case WarnDeprecated:
+		return fmt.Sprintf("if %[1]s.IsDeprecated() {\nDeprecated%[2]sHandler(%[1]s)\n}\n", value, enumType)
+	case RejectDeprecated:
+		return fmt.Sprintf(
+			"if %s.IsDeprecated() {\nreturn %s\n}\n", value, g.invalidErr(enumType, "deprecated %v", value),
+		)
+	default:
+		return ""
+	}
+}
+
+// expectsErr returns the statement returning the error of a string not representing the enum kind.
+func (g *Generator) expectsErr(enumType string, enumKind Kind, value string) string {
+	return "return " + g.invalidErr(enumType, "expects "+enumKind.Name()+" but got %s", value) + "\n"
+}
+
+// invalidErr returns the expression of an error wrapping ErrInvalid<T>, detailed by the format and its arguments.
+// Without the fmt package, the detail is concatenated: %d formats an int, %s and %q a string or bytes,
+// and %v an enum value.
+func (g *Generator) invalidErr(enumType, format string, args ...string) string {
+	if !g.nofmt {
+		args = append([]string{"ErrInvalid" + enumType}, args...)
+		return fmt.Sprintf("fmt.Errorf(%q, %s)", "%w: "+format, strings.Join(args, ", "))
+	}
+	var parts []string
+	for _, arg := range args {
+		i := strings.IndexByte(format, '%')
+		if i < 0 || i+1 == len(format) {
+			break
+		}
+		if i > 0 {
+			parts = append(parts, strconv.Quote(format[:i]))
+		}
+		parts = append(parts, g.strConvVerb(format[i+1], arg))
+		format = format[i+2:]
+	}
+	if format != "" {
+		parts = append(parts, strconv.Quote(format))
+	}
+	return fmt.Sprintf("invalid%sError(%s)", enumType, strings.Join(parts, " + "))
+}
+
+// strConvVerb returns the expression formatting the value as the fmt verb, without the fmt package.
+func (g *Generator) strConvVerb(verb byte, value string) string {
+	switch verb {
+	case 'd':
+		return "strconv.Itoa(" + value + ")"
+	case 'q':
+		return "strconv.Quote(" + stringOf(value) + ")"
+	case 's':
+		return stringOf(value)
+	default:
+		return strConvValue(g.kind(), value)
+	}
+}
+
+// kind returns the base type of the enums.
+func (g *Generator) kind() Kind {
+	if len(g.enums) == 0 {
+		return Int
+	}
+	return g.enums[0].Kind
+}
+
+// concatFormat returns the expression concatenating the name, the value and the type of the enum
+// as formatted by the Stringer format, without the fmt package.
+// Only the verbs without flag, width or precision are supported.
+func concatFormat(format, name, enumType string, enumKind Kind) (string, error) {
+	var (
+		parts []string
+		lit   strings.Builder
+		arg   = NamePos
+	)
+	flush := func() {
+		if lit.Len() > 0 {
+			parts = append(parts, strconv.Quote(lit.String()))
+			lit.Reset()
+		}
+	}
+	for i := 0; i < len(format); i++ {
+		if format[i] != '%' {
+			lit.WriteByte(format[i])
+			continue
+		}
+		if i++; i < len(format) && format[i] == '%' {
+			lit.WriteByte('%')
+			continue
+		}
+		if i < len(format) && format[i] == '[' {
+			j := strings.IndexByte(format[i:], ']')
+			if j < 0 {
+				return "", fmt.Errorf("stringer format %q: %w", format, ErrInvalid)
+			}
+			n, err := strconv.Atoi(format[i+1 : i+j])
+			if err != nil {
+				return "", fmt.Errorf("stringer format %q: %w", format, ErrInvalid)
+			}
+			arg, i = Pos(n), i+j+1
+		}
+		if i == len(format) {
+			return "", fmt.Errorf("stringer format %q: %w", format, ErrInvalid)
+		}
+		switch verb := format[i]; {
+		case arg == NamePos && (verb == 's' || verb == 'v'):
+			flush()
+			parts = append(parts, name)
+		case arg == NamePos && verb == 'q':
+			flush()
+			parts = append(parts, "strconv.Quote("+name+")")
+		case arg == ValuePos && (verb == 'v' || verb == 'd' && enumKind.IsInteger() || verb == 's' && !enumKind.IsNumber()):
+			flush()
+			parts = append(parts, strConvValue(enumKind, shortName))
+		case arg == ValuePos && verb == 'f' && enumKind.IsNumber() && !enumKind.IsInteger():
+			flush()
+			parts = append(parts, fmt.Sprintf("strconv.FormatFloat(float64(%s), 'f', 6, %d)", shortName, enumKind.BitSize()))
+		case arg == ValuePos && verb == 'q' && !enumKind.IsNumber():
+			flush()
+			parts = append(parts, "strconv.Quote("+stringOf(shortName)+")")
+		case arg == TypePos && (verb == 's' || verb == 'v'):
+			lit.WriteString(enumType)
+		case arg == TypePos && verb == 'q':
+			lit.WriteString(strconv.Quote(enumType))
+		default:
+			return "", fmt.Errorf("stringer format %q: verb %%%c of %s: %w", format, verb, arg, ErrInvalid)
+		}
+		arg++
+	}
+	flush()
+	if len(parts) == 0 {
+		return `""`, nil
+	}
+	return strings.Join(parts, " + "), nil
+}
+
+// concatStringer prints the returns of the String method, without the fmt package.
+func (g *Generator) concatStringer(format, enumType string, enumKind Kind) error {
+	unknown, err := concatFormat(DefaultFormat(enumKind.ValueFormat()), `""`, enumType, enumKind)
+	if err != nil {
+		return err
+	}
+	known := strName
+	if format != NameFormat() {
+		known, err = concatFormat(format, strName, enumType, enumKind)
+		if err != nil {
+			return err
+		}
+	}
+	g.printf("if !ok {\n")
+	g.printf("return %s\n", unknown)
+	g.printf("}\n")
+	g.printf("return %s\n", known)
+	g.printf("}\n")
+
+	return nil
+}
+
+func (g *Generator) printFuzzText(enumType string) {
+	g.printf("\n")
+	g.printf("func FuzzParse%s(f *testing.F) {\n", enumType)
+	g.printf("for _, %s := range _%sTestValues {\n", shortName, enumType)
+	g.printf("f.Add(%s.String())\n", shortName)
+	g.printf("}\n")
+	g.printf("f.Fuzz(func(t *testing.T, %s string) {\n", strName)
+	g.printf("var %s %s\n", shortName, enumType)
+	g.printf("if %s.UnmarshalText([]byte(%s)) != nil {\n", shortName, strName)
+	g.printf("return\n")
+	g.printf("}\n")
+	if g.decoding == OpenDecoding && g.unknown == "" {
+		// Unknown values are kept as is.
+		g.printf("if _, ok := lookup%s(%s); !ok {\n", enumType, shortName)
+		g.printf("return\n")
+		g.printf("}\n")
+	}
+	g.printf("var %s2 %s\n", shortName, enumType)
+	g.printf("if err := %[1]s2.UnmarshalText([]byte(%[1]s.String())); err != nil || %[1]s2 != %[1]s {\n", shortName)
+	g.printf("t.Errorf(\"%%q: parsed as %%v\", %s, %s)\n", strName, shortName)
+	g.printf("}\n")
+	g.printf("})\n")
+	g.printf("}\n")
+}
+
+// printBenchText prints the benchmarks of the text representation of the known constants, and of their parsing.
+func (g *Generator) printBenchText(enumType string) {
+	g.printf("\n")
+	g.printf("func Benchmark%s_String(b *testing.B) {\n", enumType)
+	g.printf("if len(_%sTestValues) == 0 {\n", enumType)
+	g.printf("b.Skip(\"no value\")\n")
+	g.printf("}\n")
+	g.printf("b.ReportAllocs()\n")
+	g.printf("for i := 0; i < b.N; i++ {\n")
+	g.printf("_ = _%[1]sTestValues[i%%len(_%[1]sTestValues)].String()\n", enumType)
+	g.printf("}\n")
+	g.printf("}\n")
+
+	g.printf("\n")
+	g.printf("func Benchmark%s_UnmarshalText(b *testing.B) {\n", enumType)
+	g.printf("texts := make([][]byte, len(_%sTestValues))\n", enumType)
+	g.printf("for k, %s := range _%sTestValues {\n", shortName, enumType)
+	g.printf("texts[k] = []byte(%s.String())\n", shortName)
+	g.printf("}\n")
+	g.printf("if len(texts) == 0 {\n")
+	g.printf("b.Skip(\"no value\")\n")
+	g.printf("}\n")
+	g.printf("b.ReportAllocs()\n")
+	g.printf("b.ResetTimer()\n")
+	g.printf("var %s %s\n", shortName, enumType)
+	g.printf("for i := 0; i < b.N; i++ {\n")
+	g.printf("if err := %s.UnmarshalText(texts[i%%len(texts)]); err != nil {\n", shortName)
+	g.printf("b.Fatal(err)\n")
+	g.printf("}\n")
+	g.printf("}\n")
+	g.printf("}\n")
+}
+
+// printBenchAppend prints the benchmark of the given append-style method, reusing the same buffer.
+func (g *Generator) printBenchAppend(enumType, method string) {
+	g.printf("\n")
+	g.printf("func Benchmark%s_%s(b *testing.B) {\n", enumType, method)
+	g.printf("if len(_%sTestValues) == 0 {\n", enumType)
+	g.printf("b.Skip(\"no value\")\n")
+	g.printf("}\n")
+	g.printf("var (\n")
+	g.printf("buf = make([]byte, 0, 64)\n")
+	g.printf("err error\n")
+	g.printf(")\n")
+	g.printf("b.ReportAllocs()\n")
+	g.printf("for i := 0; i < b.N; i++ {\n")
+	g.printf("buf, err = _%[1]sTestValues[i%%len(_%[1]sTestValues)].%[2]s(buf[:0])\n", enumType, method)
+	g.printf("if err != nil {\n")
+	g.printf("b.Fatal(err)\n")
+	g.printf("}\n")
+	g.printf("}\n")
+	g.printf("}\n")
+}
+
+// printTestAliases prints a test checking that each alias is parsed as its enum, then represented by its canonical text.
+func (g *Generator) printTestAliases(format, enumType string) error {
+	var aliases [][2]string
+	for k, e := range g.enums {
+		if e.Text == unnamed || (e.Deprecated != "" && g.deprecation == RejectDeprecated) {
+			continue
+		}
+		for _, name := range e.Aliases {
+			e2 := e
+			e2.RawText = name
+			s, err := enumText(e2, format)
+			if err != nil {
+				return fmt.Errorf("enum value #%d: %w", k, err)
+			}
+			aliases = append(aliases, [2]string{s, e.Text})
+		}
+	}
+	if len(aliases) == 0 {
+		return nil
+	}
+	g.printf("\n")
+	g.printf("var _%sTestAliases = map[string]%s{\n", enumType, enumType)
+	for _, a := range aliases {
+		g.printf("%q: %s,\n", a[0], a[1])
+	}
+	g.printf("}\n")
+	g.printf("\n")
+	g.printf("func Test%s_Aliases(t *testing.T) {\n", enumType)
+	g.printf("for %s, %s := range _%sTestAliases {\n", strName, shortName, enumType)
+	g.printf("var %s2 %s\n", shortName, enumType)
+	g.printf("if err := %s2.UnmarshalText([]byte(%s)); err != nil || %s2 != %s {\n", shortName, strName, shortName, shortName)
+	g.printf("t.Errorf(\"%%q: alias of %%v parsed as %%v: %%v\", %s, %s, %s2, err)\n", strName, shortName, shortName)
+	g.printf("}\n")
+	g.printf("}\n")
+	g.printf("}\n")
+
+	return nil
+}
+
+// printTestLabels prints a test checking that the label of each known constant is parsed as it in each language.
+func (g *Generator) printTestLabels(enumType string) {
+	quoted := make([]string, len(g.langs))
+	for k, lang := range g.langs {
+		quoted[k] = strconv.Quote(lang)
+	}
+	g.printf("\n")
+	g.printf("func Test%s_Label(t *testing.T) {\n", enumType)
+	g.printf("for _, lang := range []string{%s} {\n", strings.Join(quoted, ", "))
+	g.printf("for _, %s := range _%sTestValues {\n", shortName, enumType)
+	g.printf("var %s2 %s\n", shortName, enumType)
+	g.printf("if err := %[1]s2.ParseLabel(lang, %[1]s.Label(lang)); err != nil || %[1]s2 != %[1]s {\n", shortName)
+	g.printf("t.Errorf(\"%%v: %%s label round-trip failed: %%v\", %s, lang, err)\n", shortName)
+	g.printf("}\n")
+	g.printf("}\n")
+	g.printf("}\n")
+	g.printf("}\n")
+}
+
+func (g *Generator) printTestBitmask(enumType string) {
+	g.printf("\n")
+	g.printf("func Test%s_Bitmask(t *testing.T) {\n", enumType)
+	g.printf("var all %s\n", enumType)
+	g.printf("for _, %s := range _%sTestValues {\n", shortName, enumType)
+	g.printf("var %s2 %s\n", shortName, enumType)
+	g.printf("if %s2.Has(%s) {\n", shortName, shortName)
+	g.printf("t.Errorf(\"%%v: unexpected flag\", %s)\n", shortName)
+	g.printf("}\n")
+	g.printf("%s2.Set(%s)\n", shortName, shortName)
+	g.printf("if !%s2.Has(%s) {\n", shortName, shortName)
+	g.printf("t.Errorf(\"%%v: Set failed\", %s)\n", shortName)
+	g.printf("}\n")
+	g.printf("%s2.Toggle(%s)\n", shortName, shortName)
+	g.printf("if %s2.Has(%s) {\n", shortName, shortName)
+	g.printf("t.Errorf(\"%%v: Toggle failed\", %s)\n", shortName)
+	g.printf("}\n")
+	g.printf("if !%[1]s2.Switch(%[1]s, true) || !%[1]s2.Has(%[1]s) {\n", shortName)
+	g.printf("t.Errorf(\"%%v: Switch failed\", %s)\n", shortName)
+	g.printf("}\n")
+	g.printf("if %s2.Switch(%s, true) {\n", shortName, shortName)
+	g.printf("t.Errorf(\"%%v: Switch done twice\", %s)\n", shortName)
+	g.printf("}\n")
+	g.printf("%s2.Unset(%s)\n", shortName, shortName)
+	g.printf("if %s2.Has(%s) {\n", shortName, shortName)
+	g.printf("t.Errorf(\"%%v: Unset failed\", %s)\n", shortName)
+	g.printf("}\n")
+	g.printf("all.Set(%s)\n", shortName)
+	g.printf("}\n")
+	g.printf("for _, %s := range _%sTestValues {\n", shortName, enumType)
+	g.printf("if !all.Has(%s) {\n", shortName)
+	g.printf("t.Errorf(\"%%v: missing flag\", %s)\n", shortName)
+	g.printf("}\n")
+	g.printf("}\n")
+	g.printf("}\n")
+}
+
+// printTestRoundTrip prints a test checking that each known constant is unchanged once marshaled then unmarshaled.
+// Both statements are formats where the first argument is the constant name and the second one its data.
+// Without marshal statement, the unmarshal one must use the constant.
+func (g *Generator) printTestRoundTrip(enumType, method, marshal, unmarshal string) {
+	g.printf("\n")
+	g.printf("func Test%s_%s(t *testing.T) {\n", enumType, method)
+	g.printf("for _, %s := range _%sTestValues {\n", shortName, enumType)
+	g.printf("var %s2 %s\n", shortName, enumType)
+	if marshal == "" {
+		g.printf("err := %s\n", fmt.Sprintf(unmarshal, shortName, srcName))
+	} else {
+		g.printf("%s, err := %s\n", srcName, fmt.Sprintf(marshal, shortName, srcName))
+		g.printf("if err == nil {\n")
+		g.printf("err = %s\n", fmt.Sprintf(unmarshal, shortName, srcName))
+		g.printf("}\n")
+	}
+	g.printf("if err != nil || %s2 != %s {\n", shortName, shortName)
+	g.printf("t.Errorf(\"%%v: %s round-trip failed: %%v\", %s, err)\n", method, shortName)
+	g.printf("}\n")
+	g.printf("}\n")
+	g.printf("}\n")
+}
+
+// printTestValues prints a test checking that each constant returned by Values is valid and returned once.
+func (g *Generator) printTestValues(enumType string) {
+	g.printf("\n")
+	g.printf("func Test%s_Values(t *testing.T) {\n", enumType)
+	g.printf("var (\n")
+	g.printf("%s %s\n", shortName, enumType)
+	g.printf("seen = make(map[%s]struct{})\n", enumType)
+	g.printf(")\n")
+	g.printf("for _, %s := range %s.Values() {\n", mixedName, shortName)
+	g.printf("if _, ok := seen[%s]; ok || !%s.IsValid() {\n", mixedName, mixedName)
+	g.printf("t.Errorf(\"%%v: expected valid and once\", %s)\n", mixedName)
+	g.printf("}\n")
+	g.printf("seen[%s] = struct{}{}\n", mixedName)
+	g.printf("}\n")
+	g.printf("}\n")
+}
+
+// printTestGroups prints a test checking that each constant of a group belongs to it.
+func (g *Generator) printTestGroups(enumType string) {
+	groups := enumGroups(g.enums)
+	for k, name := range groups {
+		groups[k] = groupName(enumType, name)
+	}
+	g.printf("\n")
+	g.printf("func Test%s_Groups(t *testing.T) {\n", enumType)
+	g.printf("for _, group := range []%s%s{%s} {\n", enumType, groupSuffix, strings.Join(groups, ", "))
+	g.printf("for _, %s := range %sValuesIn(group) {\n", shortName, enumType)
+	g.printf("if %s.Group() != group {\n", shortName)
+	g.printf("t.Errorf(\"%%v: expected in the group %%s\", %s, group)\n", shortName)
+	g.printf("}\n")
+	g.printf("}\n")
+	g.printf("}\n")
+	g.printf("}\n")
+}
+
+// printTestOrdered prints a test walking through the constants from the first to the last one.
+func (g *Generator) printTestOrdered(enumType string) {
+	g.printf("\n")
+	g.printf("func Test%s_Order(t *testing.T) {\n", enumType)
+	g.printf("var (\n")
+	g.printf("%s = First%s\n", shortName, enumType)
+	g.printf("n = 1\n")
+	g.printf(")\n")
+	g.printf("for {\n")
+	g.printf("next, ok := %s.Next()\n", shortName)
+	g.printf("if !ok {\n")
+	g.printf("break\n")
+	g.printf("}\n")
+	g.printf("if !%[1]s.Less(next) || next.Compare(%[1]s) != 1 {\n", shortName)
+	g.printf("t.Errorf(\"%%v: expected before %%v\", %s, next)\n", shortName)
+	g.printf("}\n")
+	g.printf("if prev, ok := next.Prev(); !ok || prev != %s {\n", shortName)
+	g.printf("t.Errorf(\"%%v: mismatch previous\", next)\n")
+	g.printf("}\n")
+	g.printf("%s = next\n", shortName)
+	g.printf("n++\n")
+	g.printf("}\n")
+	g.printf("if %s != Last%s || n != len(_%sOrder) {\n", shortName, enumType, enumType)
+	g.printf("t.Errorf(\"%%v: mismatch last after %%d constants\", %s, n)\n", shortName)
+	g.printf("}\n")
+	g.printf("}\n")
+}
+
+// printTestTransitions prints a test checking that each next state of a constant is an allowed transition.
+func (g *Generator) printTestTransitions(enumType string) {
+	g.printf("\n")
+	g.printf("func Test%s_Transitions(t *testing.T) {\n", enumType)
+	g.printf("for _, %s := range _%sTestValues {\n", shortName, enumType)
+	g.printf("next := %s.NextStates()\n", shortName)
+	g.printf("if %s.IsTerminal() != (len(next) == 0) {\n", shortName)
+	g.printf("t.Errorf(\"%%v: mismatch terminal\", %s)\n", shortName)
+	g.printf("}\n")
+	g.printf("for _, %s := range next {\n", mixedName)
+	g.printf("if !%s.CanTransitionTo(%s) {\n", shortName, mixedName)
+	g.printf("t.Errorf(\"%%v: expected transition to %%v\", %s, %s)\n", shortName, mixedName)
+	g.printf("}\n")
+	g.printf("}\n")
+	g.printf("}\n")
+	g.printf("}\n")
+}
+
+// printTestSet prints a test checking the membership operations of the set and its JSON round-trip if enabled.
+func (g *Generator) printTestSet(enumType string, withJSON bool) {
+	setType := enumType + setSuffix
+	g.printf("\n")
+	g.printf("func Test%s(t *testing.T) {\n", setType)
+	g.printf("var (\n")
+	g.printf("%s = New%s(_%sTestValues...)\n", strName, setType, enumType)
+	g.printf("s2 %s\n", setType)
+	g.printf(")\n")
+	if withJSON {
+		g.printf("b, err := json.Marshal(%s)\n", strName)
+		g.printf("if err != nil {\n")
+		g.printf("t.Fatalf(\"%%s: unexpected error: %%s\", %s, err)\n", strName)
+		g.printf("}\n")
+		g.printf("err = json.Unmarshal(b, &s2)\n")
+		g.printf("if err != nil || s2.Len() != %[1]s.Len() || s2.Intersect(%[1]s).Len() != %[1]s.Len() {\n", strName)
+		g.printf("t.Fatalf(\"%%s: mismatch round-trip: %%s, %%v\", b, s2, err)\n")
+		g.printf("}\n")
+	} else {
+		g.printf("s2 = New%s(%s.Values()...)\n", setType, strName)
+		g.printf("if s2.Len() != %[1]s.Len() || s2.Intersect(%[1]s).Len() != %[1]s.Len() {\n", strName)
+		g.printf("t.Fatalf(\"%%s: mismatch values: %%s\", %s, s2)\n", strName)
+		g.printf("}\n")
+	}
+	g.printf("for _, %s := range %s.Values() {\n", shortName, strName)
+	g.printf("s2.Remove(%s)\n", shortName)
+	g.printf("if s2.Contains(%[1]s) || !s2.Union(%[2]s).Contains(%[1]s) {\n", shortName, strName)
+	g.printf("t.Errorf(\"%%v: mismatch membership\", %s)\n", shortName)
+	g.printf("}\n")
+	g.printf("}\n")
+	g.printf("if s2.Len() != 0 {\n")
+	g.printf("t.Errorf(\"%%s: expected empty set\", s2)\n")
+	g.printf("}\n")
+	g.printf("}\n")
+}
+
+func (g *Generator) printTestValidator(enumType string, bitmask bool) error {
+	edges, err := edgeValues(g.enums, bitmask)
+	if err != nil {
+		return fmt.Errorf("enum edges: %w", err)
+	}
+	g.printf("\n")
+	g.printf("func Test%s_IsValid(t *testing.T) {\n", enumType)
+	g.printf("for _, %s := range _%sTestValues {\n", shortName, enumType)
+	g.printf("if !%[1]s.IsValid() || %[1]s.Validate() != nil {\n", shortName)
+	g.printf("t.Errorf(\"%%v: expected valid\", %s)\n", shortName)
+	g.printf("}\n")
+	g.printf("}\n")
+	if len(edges) > 0 {
+		for k, v := range edges {
+			edges[k] = enumType + "(" + v + ")"
+		}
+		g.printf("for _, %s := range []%s{%s} {\n", shortName, enumType, strings.Join(edges, ", "))
+		g.printf("if %[1]s.IsValid() || !errors.Is(%[1]s.Validate(), ErrInvalid%[2]s) {\n", shortName, enumType)
+		g.printf("t.Errorf(\"%%v: expected invalid\", %s)\n", shortName)
+		g.printf("}\n")
+		g.printf("}\n")
+	}
+	g.printf("}\n")
+
+	return nil
+}
 
-func (g Generator) mode() mode {
-	n := len(g.enums)
-	if n == 0 || g.basic {
-		return basic
+// mode returns the lookup to use: the requested one or the most suitable one.
+// Consecutive integer values use one array if their span can be held by their kind, a few values a switch,
+// a few runs of consecutive values one array by run, and other ones a map.
+func (g *Generator) mode() Lookup {
+	if g.lookup != AutoLookup {
+		return g.lookup
 	}
-	if n > maxHumanScale {
-		return advance
+	runs := enumRuns(g.enums)
+	switch {
+	case len(runs) == 1 && spanFits(runs[0]):
+		return ArrayLookup
+	case len(namedEnums(g.enums)) <= g.switchMax:
+		return SwitchLookup
+	case len(runs) > 0 && len(runs) <= maxRuns:
+		return RunsLookup
+	default:
+		return MapLookup
 	}
-	return average
 }
 
 // printf formats according to a format specifier and writes to the Generator's buffer if no error has already occurred.
@@ -41,6 +41,8 @@ const (
 	DefaultType = "Enum"
 	// DefaultKind is the default base type for an enum.
 	DefaultKind = "int"
+	// DefaultSwitchMax is the default maximum number of values looked up with a switch statement.
+	DefaultSwitchMax = 10
 )
 
 // NameFormat returns the format used to return the enum name.
@@ -66,16 +68,36 @@ type Settings interface {
 	TypeName() string
 	TypeKind() Kind
 	Bitmask() bool
+	BinaryMarshaler() bool
 	Commented() bool
+	Decoding() Decoding
+	Deprecation() Deprecation
+	FlagValue() bool
 	JoinPrefix() bool
+	NoFmt() bool
 	TrimPrefix() bool
 	Validator() bool
+	Values() bool
 	Iota() bool
+	GraphQLMarshaler() bool
+	GraphQLSchema() string
+	Header() bool
 	JSONMarshaler() bool
+	Lookup() Lookup
+	Ordered() bool
+	Order() Order
+	Register() bool
+	Set() bool
 	TextMarshaler() bool
 	XMLMarshaler() bool
+	YAMLMarshaler() bool
+	YAMLNode() bool
 	Stringer() bool
 	StringFormater() string
+	SwitchMax() int
+	TestFilename() string
+	Transitions() io.Reader
+	Unknown() string
 }
 
 func dependencies(s Settings) map[string]struct{} {
@@ -83,20 +105,76 @@ func dependencies(s Settings) map[string]struct{} {
 		return nil
 	}
 	dep := make(map[string]struct{})
+	switch s.Deprecation() {
+	case WarnDeprecated:
+		dep["log"] = struct{}{}
+	case RejectDeprecated:
+		dep["fmt"] = struct{}{}
+	}
+	if s.BinaryMarshaler() {
+		if s.TypeKind().IsNumber() && !s.TypeKind().IsInteger() && !s.Bitmask() {
+			dep["math"] = struct{}{}
+		}
+		dep["fmt"] = struct{}{}
+	}
 	if s.JSONMarshaler() {
 		if s.TypeKind().IsNumber() {
 			dep["strconv"] = struct{}{}
 		}
 		dep["encoding/json"] = struct{}{}
+		dep["fmt"] = struct{}{}
 	}
 	if s.XMLMarshaler() {
 		if s.TypeKind().IsNumber() {
 			dep["strconv"] = struct{}{}
 		}
 		dep["encoding/xml"] = struct{}{}
+		dep["fmt"] = struct{}{}
+	}
+	if s.FlagValue() && s.Bitmask() {
+		dep["strings"] = struct{}{}
+	}
+	if s.GraphQLMarshaler() {
+		dep["io"] = struct{}{}
+		dep["strconv"] = struct{}{}
+	}
+	if s.Register() {
+		dep["github.com/rvflash/genum/registry"] = struct{}{}
+	}
+	if s.Set() {
+		dep["strings"] = struct{}{}
+	}
+	if s.YAMLNode() {
+		dep["gopkg.in/yaml.v3"] = struct{}{}
 	}
 	if s.Stringer() {
 		dep["fmt"] = struct{}{}
 	}
+	if hasDecoder(s) {
+		dep["errors"] = struct{}{}
+		dep["fmt"] = struct{}{}
+	}
+	if s.NoFmt() {
+		noFmt(s, dep)
+	}
 	return dep
 }
+
+// noFmt replaces the fmt and log packages by the strconv and os ones, to concatenate the strings instead.
+func noFmt(s Settings, dep map[string]struct{}) {
+	if _, ok := dep["log"]; ok {
+		delete(dep, "log")
+		dep["os"] = struct{}{}
+	}
+	delete(dep, "fmt")
+	// Strings are quoted, as the numbers and the sizes formatted by the strconv package.
+	if s.Stringer() || s.BinaryMarshaler() ||
+		(s.Bitmask() || s.TypeKind().IsNumber()) && (s.Validator() || s.Deprecation() != AcceptDeprecated) {
+		dep["strconv"] = struct{}{}
+	}
+}
+
+// hasDecoder returns true if the settings require a method returning the invalid value error.
+func hasDecoder(s Settings) bool {
+	return s.Validator() || s.TextMarshaler() || s.JSONMarshaler() || s.XMLMarshaler() || s.BinaryMarshaler()
+}
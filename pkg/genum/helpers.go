@@ -5,32 +5,19 @@
 package genum
 
 import (
+	"encoding/csv"
+	"encoding/json"
 	"fmt"
+	"go/token"
+	"io"
 	"math"
+	"sort"
 	"strconv"
 	"strings"
 
 	"github.com/rvflash/naming"
 )
 
-func absDiff(cur uint64, curSign bool, prev uint64, prevSign bool) uint64 {
-	if curSign == prevSign {
-		return cur - prev
-	}
-	cInt, pInt := int64(cur), int64(prev)
-	if curSign {
-		cInt *= -1
-	}
-	if prevSign {
-		pInt *= -1
-	}
-	cInt -= pInt
-	if cInt < 0 {
-		cInt *= -1
-	}
-	return uint64(cInt)
-}
-
 func bitmaskIota(curIota uint64) string {
 	if curIota == 1 {
 		return "1 << iota"
@@ -39,16 +26,28 @@ func bitmaskIota(curIota uint64) string {
 }
 
 func bitmaskValue(curIota uint64) string {
-	return strconv.FormatInt(1<<curIota, base10)
+	return strconv.FormatUint(1<<(curIota-1), base10)
+}
+
+// bitmaskMask returns the union of the n first flags.
+func bitmaskMask(n int) uint64 {
+	if n >= bits64 {
+		return math.MaxUint64
+	}
+	return 1<<n - 1
 }
 
-func calculateIota(cur uint64, curSign bool, prev uint64, prevSign bool) string {
-	delta, sign := sumNumbers(true, cur, curSign, prev, prevSign)
-	if delta <= 1 {
-		if prev == 0 {
-			return increment
+// calculateIota returns the iota expression of the current value, empty if it is the one of the previous value.
+func calculateIota(cur uint64, curSign bool, prev uint64, prevSign bool, curIota uint64) string {
+	delta, sign := sumNumbers(true, cur, curSign, curIota, false)
+	if curIota > 0 {
+		prevDelta, prevDeltaSign := sumNumbers(true, prev, prevSign, curIota-1, false)
+		if delta == prevDelta && sign == prevDeltaSign {
+			return ""
 		}
-		return ""
+	}
+	if delta == 0 {
+		return increment
 	}
 	if sign {
 		return increment + " + -" + strconv.FormatUint(delta, base10)
@@ -56,12 +55,125 @@ func calculateIota(cur uint64, curSign bool, prev uint64, prevSign bool) string
 	return increment + " + " + strconv.FormatUint(delta, base10)
 }
 
-func enumName(data []string, enumType string, joinPrefix, trimPrefix bool) string {
-	name, ok := field(data, namePos)
+// edgeValues returns the values surrounding the known ones, that can be held by the enum kind.
+// With bitmask, these values are the absence of flag and the first unknown one.
+func edgeValues(enums []Enum, bitmask bool) ([]string, error) {
+	if len(enums) == 0 {
+		return nil, nil
+	}
+	kind := enums[0].Kind
+	if bitmask {
+		res := []string{zero}
+		if n := len(enums); n < kind.BitSize() {
+			res = append(res, strconv.FormatUint(1<<n, base10))
+		}
+		return res, nil
+	}
+	values := make([]interface{}, len(enums))
+	for k, e := range enums {
+		v, err := e.ParseValue()
+		if err != nil {
+			return nil, fmt.Errorf("enum %q: %w", e.Value, err)
+		}
+		values[k] = v
+	}
+	switch {
+	case kind == String:
+		for _, v := range values {
+			if v == `""` {
+				return nil, nil
+			}
+		}
+		return []string{`""`}, nil
+	case !kind.IsInteger():
+		return floatEdges(values, kind), nil
+	case kind.IsSigned():
+		return signedEdges(values, kind), nil
+	default:
+		return unsignedEdges(values, kind), nil
+	}
+}
+
+func floatEdges(values []interface{}, kind Kind) []string {
+	min, max := math.Inf(1), math.Inf(-1)
+	for _, v := range values {
+		f, _ := v.(float64)
+		min, max = math.Min(min, f), math.Max(max, f)
+	}
+	next := func(f, to float64) float64 {
+		if kind.BitSize() == bits32 {
+			return float64(math.Nextafter32(float32(f), float32(to)))
+		}
+		return math.Nextafter(f, to)
+	}
+	var res []string
+	for _, f := range []float64{next(min, math.Inf(-1)), next(max, math.Inf(1))} {
+		if !math.IsInf(f, 0) {
+			res = append(res, strconv.FormatFloat(f, 'g', -1, kind.BitSize()))
+		}
+	}
+	return res
+}
+
+func signedEdges(values []interface{}, kind Kind) []string {
+	var (
+		lowest  = int64(-1) << (kind.BitSize() - 1)
+		highest = -(lowest + 1)
+		min     = highest
+		max     = lowest
+	)
+	for _, v := range values {
+		i, _ := v.(int64)
+		if i < min {
+			min = i
+		}
+		if i > max {
+			max = i
+		}
+	}
+	var res []string
+	if min > lowest {
+		res = append(res, strconv.FormatInt(min-1, base10))
+	}
+	if max < highest {
+		res = append(res, strconv.FormatInt(max+1, base10))
+	}
+	return res
+}
+
+func unsignedEdges(values []interface{}, kind Kind) []string {
+	var (
+		highest  = uint64(math.MaxUint64) >> (bits64 - kind.BitSize())
+		min, max = highest, uint64(0)
+	)
+	for _, v := range values {
+		u, _ := v.(uint64)
+		if u < min {
+			min = u
+		}
+		if u > max {
+			max = u
+		}
+	}
+	var res []string
+	if min > 0 {
+		res = append(res, strconv.FormatUint(min-1, base10))
+	}
+	if max < highest {
+		res = append(res, strconv.FormatUint(max+1, base10))
+	}
+	return res
+}
+
+func enumName(data []string, c columns, enumType string, joinPrefix, trimPrefix bool) string {
+	name, ok := c.field(data, nameColumn)
 	if !ok || name == "" {
 		return unnamed
 	}
 	name = naming.PascalCase(name)
+	if name == "" {
+		return unnamed
+	}
 	if trimPrefix {
 		name = strings.TrimPrefix(name, enumType)
 	}
@@ -71,13 +183,453 @@ func enumName(data []string, enumType string, joinPrefix, trimPrefix bool) strin
 	return name
 }
 
-func enumRawName(data []string) string {
-	s, _ := field(data, namePos)
+func enumRawName(data []string, c columns) string {
+	s, _ := c.field(data, nameColumn)
 	return s
 }
 
+// enumDeprecated returns the deprecation message of the enum, empty if it is not deprecated.
+// A boolean value can be used instead of a message to use the default one.
+func enumDeprecated(data []string, c columns, enumName string) string {
+	s, _ := c.field(data, deprecatedColumn)
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return ""
+	}
+	b, err := strconv.ParseBool(s)
+	switch {
+	case err != nil:
+		return s
+	case b:
+		return enumName + " should no longer be used."
+	default:
+		return ""
+	}
+}
+
+// enumAliases returns the other spellings accepted by the parsers for this enum.
+func enumAliases(data []string, c columns) []string {
+	s, _ := c.field(data, aliasesColumn)
+	var res []string
+	for _, v := range strings.Split(s, aliasSep) {
+		if v = strings.TrimSpace(v); v != "" {
+			res = append(res, v)
+		}
+	}
+	return res
+}
+
+// enumLabels returns the labels of the enum by language, the ones of its name without translation
+// in the first language.
+func enumLabels(data []string, c columns) map[string]string {
+	langs := c.langs()
+	if len(langs) == 0 {
+		return nil
+	}
+	res := make(map[string]string, len(langs))
+	for _, lang := range langs {
+		if s, _ := c.field(data, labelColumn+lang); s != "" {
+			res[lang] = s
+		}
+	}
+	if res[langs[0]] == "" {
+		res[langs[0]], _ = c.field(data, nameColumn)
+	}
+	return res
+}
+
+// enumMetadata returns the Go literals of the metadata of the enum by column name, validated against their type.
+func enumMetadata(data []string, c columns) (map[string]string, error) {
+	cols := c.metadata()
+	if len(cols) == 0 {
+		return nil, nil
+	}
+	res := make(map[string]string, len(cols))
+	for _, m := range cols {
+		s, _ := c.field(data, m.String())
+		v, err := m.literal(strings.TrimSpace(s))
+		if err != nil {
+			return nil, fmt.Errorf("column %q: %w", m, err)
+		}
+		res[m.name] = v
+	}
+	return res, nil
+}
+
+// checkLabels returns an error if two enums have the same label in a language, once translated with its fallbacks.
+func checkLabels(enums []Enum, langs []string) error {
+	for _, lang := range langs {
+		known := make(map[string]string, len(enums))
+		for _, e := range enums {
+			var s string
+			for _, l := range labelLangs(lang, langs[0]) {
+				if s = e.Labels[l]; s != "" {
+					break
+				}
+			}
+			if name, ok := known[s]; ok && s != "" {
+				return fmt.Errorf("enum %q: label %q already used by %q in %q: %w", e.RawText, s, name, lang, ErrInvalid)
+			}
+			known[s] = e.RawText
+		}
+	}
+	return nil
+}
+
+// checkMetadata returns an error if two enums sharing the same value have different metadata,
+// since only the metadata of the first one can be returned by the accessors.
+func checkMetadata(enums []Enum, metadata []metaColumn) error {
+	known := make(map[interface{}]Enum, len(enums))
+	for _, e := range enums {
+		if e.Text == unnamed {
+			continue
+		}
+		v := enumKey(e)
+		e2, ok := known[v]
+		if !ok {
+			known[v] = e
+			continue
+		}
+		for _, m := range metadata {
+			if e.Metadata[m.name] != e2.Metadata[m.name] {
+				return fmt.Errorf(
+					"enum %q: metadata %q %s differs from %s of %q with the same value: %w",
+					e.RawText, m.name, e.Metadata[m.name], e2.Metadata[m.name], e2.RawText, ErrInvalid,
+				)
+			}
+		}
+	}
+	return nil
+}
+
+// labelLang returns the language as used by the generated code: lower case with hyphen as separator.
+func labelLang(s string) string {
+	return strings.ToLower(strings.ReplaceAll(strings.TrimSpace(s), "_", "-"))
+}
+
+// labelLangs returns the languages to use to translate a label in the given language, by order of preference:
+// the language, its base language and the default one.
+func labelLangs(lang, defaultLang string) []string {
+	res := []string{lang}
+	if i := strings.Index(lang, "-"); i > 0 {
+		res = append(res, lang[:i])
+	}
+	return append(res, defaultLang)
+}
+
+// isLang returns true if s can be used as language, like "fr" or "en-us".
+func isLang(s string) bool {
+	if s == "" || s[0] == '-' || s[len(s)-1] == '-' {
+		return false
+	}
+	for _, r := range s {
+		switch {
+		case r == '-', r >= 'a' && r <= 'z', r >= '0' && r <= '9':
+		default:
+			return false
+		}
+	}
+	return true
+}
+
+// enumRetired returns true if the enum is retired.
+func enumRetired(data []string, c columns) (bool, error) {
+	s, _ := c.field(data, retiredColumn)
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return false, nil
+	}
+	return strconv.ParseBool(s)
+}
+
+// newEnum returns an enum, without value, based on the name and the metadata of this record.
+// A retired enum is unnamed to only reserve its value.
+func newEnum(data []string, c columns, enumType string, joinPrefix, trimPrefix bool) (e Enum, err error) {
+	e = Enum{
+		Type:    enumType,
+		Text:    enumName(data, c, enumType, joinPrefix, trimPrefix),
+		RawText: enumRawName(data, c),
+	}
+	e.Group, _ = c.field(data, groupColumn)
+	e.Group = strings.TrimSpace(e.Group)
+	e.Retired, err = enumRetired(data, c)
+	if err != nil {
+		return e, fmt.Errorf("enum %q: retired: %w", e.RawText, err)
+	}
+	if e.Retired {
+		e.Text = unnamed
+		return e, nil
+	}
+	e.Deprecated = enumDeprecated(data, c, e.Text)
+	e.Aliases = enumAliases(data, c)
+	e.Labels = enumLabels(data, c)
+	e.Metadata, err = enumMetadata(data, c)
+	if err != nil {
+		return e, fmt.Errorf("enum %q: %w", e.RawText, err)
+	}
+	return e, nil
+}
+
+// recordLines returns the lines where the record starts and ends in the source.
+func recordLines(r *csv.Reader, data []string) (start, end int) {
+	if len(data) == 0 {
+		return 0, 0
+	}
+	start, _ = r.FieldPos(0)
+	end, _ = r.FieldPos(len(data) - 1)
+	return start, end + strings.Count(data[len(data)-1], "\n")
+}
+
+// followEnum returns the enum with the group of the previous one if its group cell is empty,
+// separated from it if its record starts after blank lines.
+func followEnum(e Enum, enums []Enum, start, prevEnd int) Enum {
+	if len(enums) == 0 {
+		return e
+	}
+	if e.Group == "" {
+		e.Group = enums[len(enums)-1].Group
+	}
+	e.Separated = start > prevEnd+1
+	return e
+}
+
+// enumBlocks splits the enums into blocks of consecutive enums of the same group, not separated by blank lines.
+func enumBlocks(enums []Enum) [][]Enum {
+	var res [][]Enum
+	for k, e := range enums {
+		if k == 0 || e.Separated || e.Group != enums[k-1].Group {
+			res = append(res, nil)
+		}
+		res[len(res)-1] = append(res[len(res)-1], e)
+	}
+	return res
+}
+
+// enumGroups returns the names of the groups of the enums, in their declaration order.
+func enumGroups(enums []Enum) []string {
+	var res []string
+	for _, e := range enums {
+		if e.Group != "" && !containsString(res, e.Group) {
+			res = append(res, e.Group)
+		}
+	}
+	return res
+}
+
+// groupName returns the name of the constant representing the group.
+func groupName(enumType, group string) string {
+	return enumType + groupSuffix + naming.PascalCase(group)
+}
+
+// checkRetired returns an error if the value of a retired enum is used by another one.
+func checkRetired(enums []Enum) error {
+	retired := make(map[interface{}]string)
+	for _, e := range enums {
+		if !e.Retired {
+			continue
+		}
+		v, err := e.ParseValue()
+		if err != nil {
+			return fmt.Errorf("enum %q: %w", e.RawText, err)
+		}
+		retired[v] = e.RawText
+	}
+	if len(retired) == 0 {
+		return nil
+	}
+	for _, e := range enums {
+		if e.Retired {
+			continue
+		}
+		v, err := e.ParseValue()
+		if err != nil {
+			return fmt.Errorf("enum %q: %w", e.RawText, err)
+		}
+		if name, ok := retired[v]; ok {
+			return fmt.Errorf("enum %q: value %s retired by %q: %w", e.RawText, e.Value, name, ErrInvalid)
+		}
+	}
+	return nil
+}
+
+// distinctEnums returns the enums without the ones sharing the value of a previous one.
+// The first named enum of each value is the canonical one, used to represent it.
+func distinctEnums(enums []Enum) []Enum {
+	named := make(map[interface{}]int, len(enums))
+	for k, e := range enums {
+		v := enumKey(e)
+		if _, ok := named[v]; !ok && e.Text != unnamed {
+			named[v] = k
+		}
+	}
+	var (
+		res  = make([]Enum, 0, len(enums))
+		seen = make(map[interface{}]struct{}, len(enums))
+	)
+	for k, e := range enums {
+		v := enumKey(e)
+		if _, ok := seen[v]; ok {
+			continue
+		}
+		if pos, ok := named[v]; ok && pos != k {
+			continue
+		}
+		seen[v] = struct{}{}
+		res = append(res, e)
+	}
+	return res
+}
+
+// namedEnums returns the distinct enums with a name.
+func namedEnums(enums []Enum) []Enum {
+	var res []Enum
+	for _, e := range distinctEnums(enums) {
+		if e.Text != unnamed {
+			res = append(res, e)
+		}
+	}
+	return res
+}
+
+// enumRuns returns the named enums sorted by value and split into runs of consecutive values.
+// It returns nothing if the enums are not integers.
+func enumRuns(enums []Enum) [][]Enum {
+	named := namedEnums(enums)
+	if len(named) == 0 || !named[0].Kind.IsInteger() {
+		return nil
+	}
+	sort.SliceStable(named, func(i, j int) bool {
+		return enumOrder(named[i]) < enumOrder(named[j])
+	})
+	var (
+		res = [][]Enum{{named[0]}}
+		cur = 0
+	)
+	for _, e := range named[1:] {
+		last := res[cur][len(res[cur])-1]
+		if enumOrder(e) == enumOrder(last)+1 {
+			res[cur] = append(res[cur], e)
+			continue
+		}
+		res = append(res, []Enum{e})
+		cur++
+	}
+	return res
+}
+
+// sortedEnums returns the named enums sorted by value.
+func sortedEnums(enums []Enum) []Enum {
+	named := namedEnums(enums)
+	sort.SliceStable(named, func(i, j int) bool {
+		switch v := enumKey(named[i]).(type) {
+		case float64:
+			w, _ := enumKey(named[j]).(float64)
+			return v < w
+		case string:
+			w, _ := enumKey(named[j]).(string)
+			return v < w
+		default:
+			return enumOrder(named[i]) < enumOrder(named[j])
+		}
+	})
+	return named
+}
+
+// setSpan returns the number of values, known or not, from the first to the last integer enum,
+// and true if they can be held by the bitset of a set.
+func setSpan(enums []Enum) (uint64, bool) {
+	runs := enumRuns(enums)
+	if len(runs) == 0 {
+		return 0, false
+	}
+	var (
+		first = runs[0][0]
+		last  = runs[len(runs)-1][len(runs[len(runs)-1])-1]
+		span  = enumOrder(last) - enumOrder(first) + 1
+	)
+	return span, span != 0 && span <= maxBitsetSpan
+}
+
+// spanFits returns true if the distance from the first to the last enum of this run,
+// sorted by value, can be held by their kind.
+func spanFits(run []Enum) bool {
+	var (
+		span = enumOrder(run[len(run)-1]) - enumOrder(run[0])
+		bits = run[0].Kind.BitSize()
+	)
+	if run[0].Kind.IsSigned() {
+		bits--
+	}
+	return bits >= bits64 || span < 1<<bits
+}
+
+// enumOrder returns the integer value of the enum as an unsigned integer, preserving the order of the signed ones.
+func enumOrder(e Enum) uint64 {
+	switch v := enumKey(e).(type) {
+	case int64:
+		return uint64(v) ^ 1<<63
+	case uint64:
+		return v
+	default:
+		return 0
+	}
+}
+
+// enumKey returns the value of the enum, its raw value if it can not be parsed.
+func enumKey(e Enum) interface{} {
+	v, err := e.ParseValue()
+	if err != nil {
+		return e.Value
+	}
+	return v
+}
+
+// textTable returns the texts of the named enums and of their aliases, sorted to be parsed with a binary search,
+// with the enum parsed from each text. Aliases are other spellings of the enum name, parsed as the enum.
+func textTable(enums []Enum, format string) (keys []string, texts map[string]Enum, err error) {
+	texts = make(map[string]Enum, len(enums))
+	keys = make([]string, 0, len(enums))
+	for k, e := range enums {
+		if e.Text == unnamed {
+			continue
+		}
+		for _, name := range append([]string{e.RawText}, e.Aliases...) {
+			e2 := e
+			e2.RawText = name
+			s, err := enumText(e2, format)
+			if err != nil {
+				return nil, nil, fmt.Errorf("enum value #%d: %w", k, err)
+			}
+			e3, ok := texts[s]
+			if ok && enumKey(e3) == enumKey(e) {
+				// Same text for the same value.
+				continue
+			}
+			if ok {
+				return nil, nil, fmt.Errorf("enum value #%d: text %q already used: %w", k, s, ErrInvalid)
+			}
+			texts[s] = e
+			keys = append(keys, s)
+		}
+	}
+	sort.Strings(keys)
+	return keys, texts, nil
+}
+
+// enumText returns the text representation of the enum based on the String format.
+func enumText(e Enum, format string) (string, error) {
+	if format == NameFormat() {
+		return e.RawText, nil
+	}
+	v, err := e.ParseValue()
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf(format, e.RawText, v, e.Type), nil
+}
+
 func enumValue(
-	data []string, kind Kind, curIota int64, prevUint uint64, prevSign bool,
+	data []string, c columns, kind Kind, curIota int64, prevUint uint64, prevSign bool,
 ) (enumValue, enumIota string, curUint uint64, curSign bool) {
 	var err error
 	defer func() {
@@ -87,23 +639,24 @@ func enumValue(
 	}()
 	switch {
 	case kind.IsInteger():
-		enumValue, enumIota, curUint, curSign, err = enumIntegerValue(data, kind, curIota, prevUint, prevSign)
+		enumValue, enumIota, curUint, curSign, err = enumIntegerValue(data, c, kind, curIota, prevUint, prevSign)
 	case kind.IsNumber():
-		enumValue, err = enumFloatValue(data)
+		enumValue, err = enumFloatValue(data, c)
 	default:
-		enumValue = enumStringValue(data)
+		enumValue = enumStringValue(data, c)
 	}
 	return
 }
 
 func enumIntegerValue(
-	data []string, kind Kind, curIota int64, prevUint uint64, prevSign bool,
+	data []string, c columns, kind Kind, curIota int64, prevUint uint64, prevSign bool,
 ) (enumValue, enumIota string, curUint uint64, curSign bool, err error) {
-	value, ok := field(data, valuePos)
-	if !ok {
+	value, ok := c.field(data, valueColumn)
+	if !ok || value == "" {
 		if curIota > -1 {
 			if curIota == 0 {
 				enumIota = increment // First value
+				return zero, enumIota, 0, false, nil
 			}
 			curUint, curSign = sumNumbers(false, prevUint, prevSign, 1, false)
 			return fmtNumber(curUint, curSign), enumIota, curUint, curSign, nil
@@ -115,14 +668,14 @@ func enumIntegerValue(
 		return value, enumIota, curUint, curSign, err
 	}
 	if curIota > -1 {
-		enumIota = calculateIota(curUint, curSign, uint64(curIota), false)
+		enumIota = calculateIota(curUint, curSign, prevUint, prevSign, uint64(curIota))
 	}
 	return value, enumIota, curUint, curSign, nil
 }
 
-func enumFloatValue(data []string) (string, error) {
-	value, ok := field(data, valuePos)
-	if !ok {
+func enumFloatValue(data []string, c columns) (string, error) {
+	value, ok := c.field(data, valueColumn)
+	if !ok || value == "" {
 		return zero, nil
 	}
 	_, err := strconv.ParseFloat(value, bits64)
@@ -132,10 +685,10 @@ func enumFloatValue(data []string) (string, error) {
 	return value, nil
 }
 
-func enumStringValue(data []string) string {
-	value, ok := field(data, valuePos)
+func enumStringValue(data []string, c columns) string {
+	value, ok := c.field(data, valueColumn)
 	if !ok {
-		value, _ = field(data, namePos)
+		value, _ = c.field(data, nameColumn)
 	}
 	return fmt.Sprintf("%q", value)
 }
@@ -208,14 +761,242 @@ func unsignedSize(n int) int {
 	}
 }
 
+func generatedBy(args []string) string {
+	return Command + " " + strings.Join(args, " ")
+}
+
+// graphQLString returns s as a GraphQL string value, whose escape sequences are the JSON ones.
+func graphQLString(s string) string {
+	b, _ := json.Marshal(s)
+	return string(b)
+}
+
+// isGraphQLName returns true if s matches the GraphQL name grammar and is not a reserved enum value.
+func isGraphQLName(s string) bool {
+	switch s {
+	case "", "true", "false", "null":
+		return false
+	}
+	for i, r := range s {
+		switch {
+		case r == '_', r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z':
+		case r >= '0' && r <= '9' && i > 0:
+		default:
+			return false
+		}
+	}
+	return true
+}
+
+// List of known columns in a CSV source.
+// Without header, the first column is the name and the second the value.
+const (
+	nameColumn       = "name"
+	valueColumn      = "value"
+	deprecatedColumn = "deprecated"
+	retiredColumn    = "retired"
+	aliasesColumn    = "aliases"
+	groupColumn      = "group"
+)
+
+// aliasSep separates the aliases of an enum in the aliases column.
+const aliasSep = "|"
+
+// labelColumn prefixes the name of the columns with the labels of the enums in a language, like "label:fr".
+const labelColumn = "label:"
+
+// Columns of the optional header of the transitions file.
+const (
+	fromColumn = "from"
+	toColumn   = "to"
+)
+
+// containsString returns true if the list contains the string.
+func containsString(list []string, s string) bool {
+	for _, v := range list {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
+
+// metaSep separates the name and the type of a metadata column, like "status:int".
+const metaSep = ":"
+
+// Types of the metadata columns, in addition to the numeric kinds.
 const (
-	namePos int = iota
-	valuePos
+	boolType   = "bool"
+	stringType = "string"
 )
 
-func field(data []string, column int) (s string, ok bool) {
-	if len(data) <= column {
+// reservedMethods lists the names of the methods that may be generated on the enum type.
+var reservedMethods = map[string]struct{}{
+	"AppendJSON": {}, "AppendText": {}, "CanTransitionTo": {}, "Compare": {}, "FlagValue": {}, "GobDecode": {},
+	"GobEncode": {}, "Group": {}, "Has": {}, "IsDeprecated": {}, "IsTerminal": {}, "IsValid": {}, "Label": {},
+	"Less": {}, "MarshalBinary": {}, "MarshalGQL": {}, "MarshalJSON": {}, "MarshalText": {}, "MarshalXML": {},
+	"MarshalYAML": {}, "Next": {}, "NextStates": {}, "ParseLabel": {}, "Prev": {}, "Set": {}, "String": {},
+	"Switch": {}, "Toggle": {}, "Type": {}, "UnmarshalBinary": {}, "UnmarshalGQL": {}, "UnmarshalJSON": {},
+	"UnmarshalText": {}, "UnmarshalXML": {}, "UnmarshalYAML": {}, "Unset": {}, "Validate": {}, "Values": {},
+}
+
+// metaColumn is a typed metadata column, generated as an accessor method of the enum.
+type metaColumn struct {
+	name string
+	typ  string
+	pos  int
+}
+
+// parseMetaColumn returns the metadata column named as "name:type" in the header.
+func parseMetaColumn(s string, pos int) (metaColumn, error) {
+	i := strings.Index(s, metaSep)
+	m := metaColumn{
+		name: strings.TrimSpace(s[:i]),
+		typ:  strings.ToLower(strings.TrimSpace(s[i+len(metaSep):])),
+		pos:  pos,
+	}
+	if !token.IsIdentifier(m.name) || !token.IsExported(m.method()) {
+		return m, fmt.Errorf("name %q: %w", m.name, ErrInvalid)
+	}
+	if _, ok := reservedMethods[m.method()]; ok {
+		return m, fmt.Errorf("method %s: reserved: %w", m.method(), ErrInvalid)
+	}
+	if m.typ != boolType && m.typ != stringType && KindNamed(m.typ).Name() != m.typ {
+		return m, fmt.Errorf("type %q: %w", m.typ, ErrInvalid)
+	}
+	return m, nil
+}
+
+// String returns the column name as declared in the header.
+func (m metaColumn) String() string {
+	return m.name + metaSep + m.typ
+}
+
+// method returns the name of the accessor method.
+func (m metaColumn) method() string {
+	return naming.PascalCase(m.name)
+}
+
+// literal returns the value as a Go literal of the column type, its zero value if empty.
+func (m metaColumn) literal(s string) (string, error) {
+	switch m.typ {
+	case stringType:
+		return strconv.Quote(s), nil
+	case boolType:
+		if s == "" {
+			return "false", nil
+		}
+		b, err := strconv.ParseBool(s)
+		if err != nil {
+			return "", fmt.Errorf("%s %q: %w", m.typ, s, ErrInvalid)
+		}
+		return strconv.FormatBool(b), nil
+	}
+	if s == "" {
+		return zero, nil
+	}
+	v, err := Enum{Kind: KindNamed(m.typ), Value: s}.ParseValue()
+	if err != nil {
+		return "", fmt.Errorf("%s %q: %w", m.typ, s, ErrInvalid)
+	}
+	switch v := v.(type) {
+	case float64:
+		if math.IsInf(v, 0) || math.IsNaN(v) {
+			return "", fmt.Errorf("%s %q: %w", m.typ, s, ErrInvalid)
+		}
+		return strconv.FormatFloat(v, 'g', -1, KindNamed(m.typ).BitSize()), nil
+	default:
+		return fmt.Sprint(v), nil
+	}
+}
+
+// columns maps each column name to its position in the CSV records.
+type columns map[string]int
+
+func (c columns) field(data []string, name string) (s string, ok bool) {
+	pos, ok := c[name]
+	if !ok || len(data) <= pos {
 		return "", false
 	}
-	return data[column], true
+	return data[pos], true
+}
+
+// langs returns the languages of the label columns, in the order of the columns.
+func (c columns) langs() []string {
+	var res []string
+	for name := range c {
+		if strings.HasPrefix(name, labelColumn) {
+			res = append(res, strings.TrimPrefix(name, labelColumn))
+		}
+	}
+	sort.Slice(res, func(i, j int) bool {
+		return c[labelColumn+res[i]] < c[labelColumn+res[j]]
+	})
+	return res
+}
+
+// metadata returns the typed metadata columns, in the order of the columns.
+func (c columns) metadata() []metaColumn {
+	var res []metaColumn
+	for name, pos := range c {
+		if strings.Contains(name, metaSep) && !strings.HasPrefix(name, labelColumn) {
+			m, _ := parseMetaColumn(name, pos)
+			res = append(res, m)
+		}
+	}
+	sort.Slice(res, func(i, j int) bool {
+		return res[i].pos < res[j].pos
+	})
+	return res
+}
+
+// readCSV returns a reader of the CSV source with the columns of its records.
+// With header, the first record is read to name the columns.
+func readCSV(data io.Reader, header bool) (*csv.Reader, columns, error) {
+	r := csv.NewReader(data)
+	r.FieldsPerRecord = -1 // Records may have a variable number of fields.
+	if !header {
+		return r, columns{nameColumn: 0, valueColumn: 1}, nil
+	}
+	d, err := r.Read()
+	if err != nil {
+		return nil, nil, fmt.Errorf("header: %w", err)
+	}
+	var (
+		c       = make(columns, len(d))
+		methods = make(map[string]string)
+	)
+	for k, v := range d {
+		name := strings.ToLower(strings.TrimSpace(v))
+		switch {
+		case name == nameColumn, name == valueColumn, name == deprecatedColumn, name == retiredColumn,
+			name == aliasesColumn, name == groupColumn:
+		case strings.HasPrefix(name, labelColumn):
+			lang := labelLang(strings.TrimPrefix(name, labelColumn))
+			if !isLang(lang) {
+				return nil, nil, fmt.Errorf("header: column %q: %w", v, ErrInvalid)
+			}
+			name = labelColumn + lang
+		case strings.Contains(name, metaSep):
+			m, err := parseMetaColumn(strings.TrimSpace(v), k)
+			if err != nil {
+				return nil, nil, fmt.Errorf("header: column %q: %w", v, err)
+			}
+			if prev, ok := methods[m.method()]; ok {
+				return nil, nil, fmt.Errorf("header: column %q: method %s of %q: %w", v, m.method(), prev, ErrInvalid)
+			}
+			methods[m.method()] = v
+			name = m.String()
+		default:
+			return nil, nil, fmt.Errorf("header: column %q: %w", v, ErrInvalid)
+		}
+		if _, ok := c[name]; ok {
+			return nil, nil, fmt.Errorf("header: duplicated column %q: %w", v, ErrInvalid)
+		}
+		c[name] = k
+	}
+	if _, ok := c[nameColumn]; !ok {
+		return nil, nil, fmt.Errorf("header: column %q: %w", nameColumn, ErrMissing)
+	}
+	return r, c, nil
 }
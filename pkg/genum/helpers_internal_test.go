@@ -5,6 +5,18 @@
 package genum
 
 import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
 	"testing"
 
 	"github.com/matryer/is"
@@ -45,3 +57,1211 @@ func TestSumNumbers(t *testing.T) {
 		})
 	}
 }
+
+func TestIsGraphQLName(t *testing.T) {
+	var (
+		are = is.New(t)
+		dt  = map[string]struct {
+			in  string
+			out bool
+		}{
+			"Default":      {},
+			"Lower":        {in: "hello", out: true},
+			"Upper":        {in: "HELLO_WORLD", out: true},
+			"Underscore":   {in: "_hello2", out: true},
+			"Digit first":  {in: "2hello"},
+			"Space":        {in: "subh din"},
+			"Dash":         {in: "en-US"},
+			"Reserved":     {in: "null"},
+			"Boolean true": {in: "true"},
+		}
+	)
+	for name, tt := range dt {
+		tt := tt
+		t.Run(name, func(t *testing.T) {
+			are.Equal(tt.out, isGraphQLName(tt.in))
+		})
+	}
+}
+
+func TestBitmaskMask(t *testing.T) {
+	var (
+		are = is.New(t)
+		dt  = map[string]struct {
+			in  int
+			out uint64
+		}{
+			"Default": {},
+			"One":     {in: 1, out: 0x1},
+			"Eight":   {in: 8, out: 0xff},
+			"Max":     {in: 64, out: 0xffffffffffffffff},
+		}
+	)
+	for name, tt := range dt {
+		tt := tt
+		t.Run(name, func(t *testing.T) {
+			are.Equal(tt.out, bitmaskMask(tt.in))
+		})
+	}
+}
+
+func TestBinaryFormat(t *testing.T) {
+	const (
+		b1 = "return []byte{byte(v)}, nil\n"
+		b2 = "return []byte{byte(v >> 8), byte(v)}, nil\n"
+		b4 = "return []byte{byte(v >> 24), byte(v >> 16), byte(v >> 8), byte(v)}, nil\n"
+		b8 = "return []byte{byte(v >> 56), byte(v >> 48), byte(v >> 40), byte(v >> 32), " +
+			"byte(v >> 24), byte(v >> 16), byte(v >> 8), byte(v)}, nil\n"
+	)
+	var (
+		are = is.New(t)
+		dt  = map[Kind]string{
+			Int:     "v := e\n" + b8,
+			Int8:    "v := e\n" + b1,
+			Int16:   "v := e\n" + b2,
+			Int32:   "v := e\n" + b4,
+			Int64:   "v := e\n" + b8,
+			Uint:    "v := e\n" + b8,
+			Uint8:   "v := e\n" + b1,
+			Uint16:  "v := e\n" + b2,
+			Uint32:  "v := e\n" + b4,
+			Uint64:  "v := e\n" + b8,
+			Float32: "v := math.Float32bits(float32(e))\n" + b4,
+			Float64: "v := math.Float64bits(float64(e))\n" + b8,
+			String:  "return []byte(e), nil\n",
+		}
+	)
+	for kind, out := range dt {
+		kind, out := kind, out
+		t.Run(kind.Name(), func(t *testing.T) {
+			are.Equal(out, binaryFormat(kind))
+		})
+	}
+}
+
+func TestBinaryParse(t *testing.T) {
+	const (
+		t1 = "T(data[0])"
+		t2 = "T(data[0])<<8 | T(data[1])"
+		t4 = "T(data[0])<<24 | T(data[1])<<16 | T(data[2])<<8 | T(data[3])"
+		t8 = "T(data[0])<<56 | T(data[1])<<48 | T(data[2])<<40 | T(data[3])<<32 | " +
+			"T(data[4])<<24 | T(data[5])<<16 | T(data[6])<<8 | T(data[7])"
+	)
+	var (
+		are = is.New(t)
+		dt  = map[Kind]string{
+			Int:    t8,
+			Int8:   t1,
+			Int16:  t2,
+			Int32:  t4,
+			Int64:  t8,
+			Uint:   t8,
+			Uint8:  t1,
+			Uint16: t2,
+			Uint32: t4,
+			Uint64: t8,
+			Float32: "T(math.Float32frombits(" +
+				"uint32(data[0])<<24 | uint32(data[1])<<16 | uint32(data[2])<<8 | uint32(data[3])))",
+			Float64: "T(math.Float64frombits(" +
+				"uint64(data[0])<<56 | uint64(data[1])<<48 | uint64(data[2])<<40 | uint64(data[3])<<32 | " +
+				"uint64(data[4])<<24 | uint64(data[5])<<16 | uint64(data[6])<<8 | uint64(data[7])))",
+			String: "T(data)",
+		}
+	)
+	for kind, out := range dt {
+		kind, out := kind, out
+		t.Run(kind.Name(), func(t *testing.T) {
+			are.Equal(out, binaryParse("T", kind))
+		})
+	}
+}
+
+func TestGenerator_BasicString(t *testing.T) {
+	var (
+		are = is.New(t)
+		dt  = map[string]struct {
+			// inputs
+			kind   Kind
+			values []string
+			// outputs
+			lookup string
+		}{
+			"Signed":          {kind: Int, values: []string{"0", "1"}, lookup: "i := uint64(e)\nif i >= uint64("},
+			"Signed offset":   {kind: Int, values: []string{"2", "3"}, lookup: "i := uint64(e) - 2\nif i >= uint64("},
+			"Signed negative": {kind: Int8, values: []string{"-100", "100"}, lookup: "i := uint64(int64(e) - (-100))\n"},
+			"Unsigned":        {kind: Uint, values: []string{"0", "1"}, lookup: "i := uint64(e)\nif i >= uint64("},
+			"Unsigned offset": {kind: Uint, values: []string{"2", "3"}, lookup: "i := uint64(e) - 2\nif i >= uint64("},
+		}
+	)
+	for name, tt := range dt {
+		tt := tt
+		t.Run(name, func(t *testing.T) {
+			g := new(Generator)
+			for k, v := range tt.values {
+				g.enums = append(g.enums, Enum{Kind: tt.kind, RawText: string(rune('a' + k)), Value: v})
+			}
+			err := g.basicString("T", tt.kind)
+			are.NoErr(err)                                        // unexpected error
+			are.True(strings.Contains(g.buf.String(), tt.lookup)) // mismatch lookup
+		})
+	}
+}
+
+func TestGenerator_Mode(t *testing.T) {
+	// seq returns the CSV records of the values from first to last.
+	seq := func(first, last int) string {
+		var b strings.Builder
+		for i := first; i <= last; i++ {
+			_, _ = fmt.Fprintf(&b, "v%d,%d\n", i-first, i)
+		}
+		return b.String()
+	}
+	var (
+		are = is.New(t)
+		dt  = map[string]struct {
+			// inputs
+			kind      Kind
+			data      string
+			lookup    Lookup
+			switchMax int
+			// outputs
+			mode Lookup
+		}{
+			"Default":         {kind: Int8, data: seq(-10, 10), switchMax: DefaultSwitchMax, mode: ArrayLookup},
+			"Unsigned span":   {kind: Uint8, data: seq(0, 255), switchMax: DefaultSwitchMax, mode: ArrayLookup},
+			"Signed span":     {kind: Int8, data: seq(-100, 100), switchMax: DefaultSwitchMax, mode: RunsLookup},
+			"Switch":          {kind: Int, data: "a,1\nb,3\nc,5", switchMax: DefaultSwitchMax, mode: SwitchLookup},
+			"Switch disabled": {kind: Int, data: "a,1\nb,3\nc,5", mode: RunsLookup},
+			"Map":             {kind: String, data: "a\nb", switchMax: 1, mode: MapLookup},
+			"Requested":       {kind: Int8, data: seq(-100, 100), lookup: ArrayLookup, mode: ArrayLookup},
+		}
+	)
+	for name, tt := range dt {
+		tt := tt
+		t.Run(name, func(t *testing.T) {
+			g := &Generator{lookup: tt.lookup, switchMax: tt.switchMax}
+			err := ParseEnums(strings.NewReader(tt.data), "T", tt.kind, false, false, false, false)(g)
+			are.NoErr(err)               // unexpected error
+			are.Equal(tt.mode, g.mode()) // mismatch mode
+		})
+	}
+}
+
+func TestEnumIntegerValue(t *testing.T) {
+	var (
+		are = is.New(t)
+		dt  = map[string]struct {
+			// inputs
+			data     []string
+			curIota  int64
+			prevUint uint64
+			// outputs
+			value string
+			iota  string
+		}{
+			"Default":     {value: zero},
+			"First iota":  {data: []string{"a"}, value: zero, iota: increment},
+			"Second iota": {data: []string{"b"}, curIota: 1, value: "1"},
+			"Next iota":   {data: []string{"c"}, curIota: 2, prevUint: 1, value: "2"},
+			"Value":       {data: []string{"d", "5"}, curIota: 3, value: "5", iota: increment + " + 2"},
+		}
+	)
+	for name, tt := range dt {
+		tt := tt
+		t.Run(name, func(t *testing.T) {
+			curIota := tt.curIota
+			if tt.data == nil {
+				curIota = -1
+			}
+			value, iota, _, _, err := enumIntegerValue(tt.data, columns{nameColumn: 0, valueColumn: 1}, Int, curIota, tt.prevUint, false)
+			are.NoErr(err)             // unexpected error
+			are.Equal(tt.value, value) // mismatch value
+			are.Equal(tt.iota, iota)   // mismatch iota
+		})
+	}
+}
+
+func TestBitmaskValue(t *testing.T) {
+	var (
+		are = is.New(t)
+		dt  = map[string]struct {
+			in  uint64
+			out string
+		}{
+			"First":  {in: 1, out: "1"},
+			"Second": {in: 2, out: "2"},
+			"Eighth": {in: 8, out: "128"},
+			"Max":    {in: 64, out: "9223372036854775808"},
+		}
+	)
+	for name, tt := range dt {
+		tt := tt
+		t.Run(name, func(t *testing.T) {
+			are.Equal(tt.out, bitmaskValue(tt.in))
+		})
+	}
+}
+
+// testSettings implements the Settings interface with the given source and marshalers.
+type testSettings struct {
+	dst  string
+	src  string
+	json bool
+	xml  bool
+	set  bool
+}
+
+func (s testSettings) DstFilename() string    { return s.dst }
+func (s testSettings) SrcFile() io.Reader     { return strings.NewReader(s.src) }
+func (testSettings) PackageName() string      { return "test" }
+func (testSettings) TypeName() string         { return DefaultType }
+func (testSettings) TypeKind() Kind           { return Int }
+func (testSettings) Bitmask() bool            { return false }
+func (testSettings) BinaryMarshaler() bool    { return false }
+func (testSettings) Commented() bool          { return false }
+func (testSettings) FlagValue() bool          { return false }
+func (testSettings) JoinPrefix() bool         { return false }
+func (testSettings) TrimPrefix() bool         { return false }
+func (testSettings) Validator() bool          { return false }
+func (testSettings) Iota() bool               { return true }
+func (testSettings) GraphQLMarshaler() bool   { return false }
+func (testSettings) GraphQLSchema() string    { return "" }
+func (s testSettings) JSONMarshaler() bool    { return s.json }
+func (testSettings) TextMarshaler() bool      { return false }
+func (s testSettings) XMLMarshaler() bool     { return s.xml }
+func (testSettings) YAMLMarshaler() bool      { return false }
+func (testSettings) YAMLNode() bool           { return false }
+func (testSettings) Stringer() bool           { return false }
+func (testSettings) StringFormater() string   { return "" }
+func (testSettings) TestFilename() string     { return "" }
+func (testSettings) Deprecation() Deprecation { return AcceptDeprecated }
+func (testSettings) Header() bool             { return false }
+func (testSettings) Decoding() Decoding       { return DefaultDecoding }
+func (testSettings) Unknown() string          { return "" }
+func (testSettings) Lookup() Lookup           { return AutoLookup }
+func (testSettings) SwitchMax() int           { return DefaultSwitchMax }
+func (testSettings) NoFmt() bool              { return false }
+func (testSettings) Values() bool             { return false }
+func (testSettings) Register() bool           { return false }
+func (s testSettings) Set() bool              { return s.set }
+func (testSettings) Transitions() io.Reader   { return nil }
+func (testSettings) Ordered() bool            { return false }
+func (testSettings) Order() Order             { return DeclarationOrder }
+
+func TestLayout(t *testing.T) {
+	var (
+		are = is.New(t)
+		dt  = map[string]struct {
+			// inputs
+			s testSettings
+			// outputs
+			out []string
+		}{
+			"Default": {out: []string{"type Enum int"}},
+			"JSON":    {s: testSettings{json: true}, out: []string{"MarshalJSON", "UnmarshalJSON"}},
+			"XML":     {s: testSettings{xml: true}, out: []string{"MarshalXML", "UnmarshalXML"}},
+		}
+	)
+	for name, tt := range dt {
+		tt := tt
+		t.Run(name, func(t *testing.T) {
+			tt.s.dst = filepath.Join(t.TempDir(), "enum.go")
+			tt.s.src = "hello\nbonjour"
+			err := Generate(Layout(tt.s, nil)...)
+			are.NoErr(err) // unexpected error
+			b, err := os.ReadFile(tt.s.dst)
+			are.NoErr(err) // unexpected read error
+			for _, s := range tt.out {
+				are.True(strings.Contains(string(b), s)) // missing declaration
+			}
+		})
+	}
+}
+
+func TestDependencies(t *testing.T) {
+	var (
+		are = is.New(t)
+		dt  = map[string]struct {
+			in  testSettings
+			out []string
+		}{
+			"Default": {out: []string{}},
+			"JSON":    {in: testSettings{json: true}, out: []string{"encoding/json", "errors", "fmt", "strconv"}},
+			"XML":     {in: testSettings{xml: true}, out: []string{"encoding/xml", "errors", "fmt", "strconv"}},
+			"Set":     {in: testSettings{set: true}, out: []string{"strings"}},
+			"Set JSON": {
+				in:  testSettings{set: true, json: true},
+				out: []string{"encoding/json", "errors", "fmt", "strconv", "strings"},
+			},
+		}
+	)
+	for name, tt := range dt {
+		tt := tt
+		t.Run(name, func(t *testing.T) {
+			dep := dependencies(tt.in)
+			out := make([]string, 0, len(dep))
+			for pkg := range dep {
+				out = append(out, pkg)
+			}
+			sort.Strings(out)
+			are.Equal(tt.out, out) // mismatch imports
+		})
+	}
+}
+
+func TestWriteFile(t *testing.T) {
+	var (
+		are = is.New(t)
+		dt  = map[string]struct {
+			src string
+			msg string
+		}{
+			"OK":      {src: "package test\n"},
+			"Invalid": {src: "package test\nfunc {", msg: "go format failed: 2:6: expected 'IDENT', found '{'"},
+		}
+	)
+	for name, tt := range dt {
+		tt := tt
+		t.Run(name, func(t *testing.T) {
+			var (
+				g        = new(Generator)
+				filename = filepath.Join(t.TempDir(), "enum.go")
+			)
+			g.printf("%s", tt.src)
+			err := WriteFile(filename)(g)
+			are.Equal(tt.msg != "", err != nil)                            // unexpected error
+			are.True(err == nil || strings.HasPrefix(err.Error(), tt.msg)) // mismatch message
+			b, err := os.ReadFile(filename)
+			are.NoErr(err)               // unexpected read error
+			are.Equal(tt.src, string(b)) // mismatch source
+		})
+	}
+}
+
+func TestWriteGraphQLSchema(t *testing.T) {
+	const data = `name,value,deprecated,retired
+pending,1,,
+active,2,"Use ""running"" instead.",
+running,3,,
+old,4,,true
+legacy,5,true,
+`
+	var (
+		are = is.New(t)
+		dt  = map[string]struct {
+			// inputs
+			prefix bool
+			format string
+			// outputs
+			golden string
+			err    error
+		}{
+			"Prefix":  {prefix: true, format: NameFormat(), golden: "status_prefix.graphql"},
+			"Format":  {format: "%[1]s_%[2]d", golden: "status_format.graphql"},
+			"Invalid": {format: "%[2]d", err: ErrInvalid},
+		}
+	)
+	for name, tt := range dt {
+		tt := tt
+		t.Run(name, func(t *testing.T) {
+			var (
+				g        = new(Generator)
+				filename = filepath.Join(t.TempDir(), "status.graphql")
+				args     = []string{"-graphql_schema", "status.graphql", "status.csv"}
+			)
+			err := ParseEnums(strings.NewReader(data), "Status", Int, tt.prefix, false, false, true)(g)
+			are.NoErr(err) // unexpected parsing error
+			err = WriteGraphQLSchema(filename, tt.format, "Status", args)(g)
+			are.True(errors.Is(err, tt.err)) // mismatch error
+			if tt.err != nil {
+				return
+			}
+			b, err := os.ReadFile(filename)
+			are.NoErr(err) // unexpected read error
+			want, err := os.ReadFile(filepath.Join("testdata", tt.golden))
+			are.NoErr(err)                     // unexpected golden error
+			are.Equal(string(want), string(b)) // mismatch schema
+		})
+	}
+}
+
+func TestEdgeValues(t *testing.T) {
+	var (
+		are = is.New(t)
+		dt  = map[string]struct {
+			// inputs
+			enums   []Enum
+			bitmask bool
+			// outputs
+			out    []string
+			failed bool
+		}{
+			"Default": {},
+			"Signed": {
+				enums: []Enum{{Kind: Int8, Value: "-2"}, {Kind: Int8, Value: "3"}},
+				out:   []string{"-3", "4"},
+			},
+			"Signed bounds": {
+				enums: []Enum{{Kind: Int8, Value: "-128"}, {Kind: Int8, Value: "127"}},
+			},
+			"Unsigned": {
+				enums: []Enum{{Kind: Uint8, Value: "0"}, {Kind: Uint8, Value: "7"}},
+				out:   []string{"8"},
+			},
+			"Unsigned bounds": {
+				enums: []Enum{{Kind: Uint64, Value: "1"}, {Kind: Uint64, Value: "18446744073709551615"}},
+				out:   []string{"0"},
+			},
+			"Float": {
+				enums: []Enum{{Kind: Float64, Value: "1.5"}},
+				out:   []string{"1.4999999999999998", "1.5000000000000002"},
+			},
+			"String": {
+				enums: []Enum{{Kind: String, Value: `"hi"`}},
+				out:   []string{`""`},
+			},
+			"Empty string": {
+				enums: []Enum{{Kind: String, Value: `""`}},
+			},
+			"Bitmask": {
+				enums:   []Enum{{Kind: Uint8, Value: "1"}, {Kind: Uint8, Value: "2"}},
+				bitmask: true,
+				out:     []string{"0", "4"},
+			},
+			"Invalid": {
+				enums:  []Enum{{Kind: Int8, Value: "rv"}},
+				failed: true,
+			},
+		}
+	)
+	for name, tt := range dt {
+		tt := tt
+		t.Run(name, func(t *testing.T) {
+			out, err := edgeValues(tt.enums, tt.bitmask)
+			are.Equal(err != nil, tt.failed) // unexpected error
+			are.Equal(tt.out, out)           // mismatch edges
+		})
+	}
+}
+
+func TestCalculateIota(t *testing.T) {
+	var (
+		are = is.New(t)
+		dt  = map[string]struct {
+			// inputs
+			cur      uint64
+			curSign  bool
+			prev     uint64
+			prevSign bool
+			curIota  uint64
+			// outputs
+			out string
+		}{
+			"Default":     {out: "iota"},
+			"First one":   {cur: 1, out: "iota + 1"},
+			"Negative":    {cur: 4, curSign: true, out: "iota + -4"},
+			"Next one":    {cur: 2, prev: 1, curIota: 1},
+			"Same offset": {cur: 6, prev: 5, curIota: 2, out: ""},
+			"Gap":         {cur: 12, prev: 2, curIota: 7, out: "iota + 5"},
+			"Duplicate":   {cur: 1, prev: 1, curIota: 1, out: "iota"},
+			"Below":       {cur: 1, prev: 4, curIota: 3, out: "iota + -2"},
+		}
+	)
+	for name, tt := range dt {
+		tt := tt
+		t.Run(name, func(t *testing.T) {
+			are.Equal(tt.out, calculateIota(tt.cur, tt.curSign, tt.prev, tt.prevSign, tt.curIota))
+		})
+	}
+}
+
+func TestDistinctEnums(t *testing.T) {
+	var (
+		are = is.New(t)
+		dt  = map[string]struct {
+			in  []Enum
+			out []string
+		}{
+			"Default": {out: []string{}},
+			"Distinct": {
+				in:  []Enum{{Text: "A", Value: "1"}, {Text: "B", Value: "2"}},
+				out: []string{"A", "B"},
+			},
+			"Duplicate": {
+				in:  []Enum{{Text: "A", Value: "1"}, {Text: "B", Value: "2"}, {Text: "C", Value: "1"}},
+				out: []string{"A", "B"},
+			},
+			"Named first": {
+				in:  []Enum{{Text: unnamed, Value: "1"}, {Text: "B", Value: "1"}, {Text: unnamed, Value: "2"}},
+				out: []string{"B", unnamed},
+			},
+		}
+	)
+	for name, tt := range dt {
+		tt := tt
+		t.Run(name, func(t *testing.T) {
+			res := distinctEnums(tt.in)
+			out := make([]string, len(res))
+			for k, e := range res {
+				out[k] = e.Text
+			}
+			are.Equal(tt.out, out)
+		})
+	}
+}
+
+func TestIsLang(t *testing.T) {
+	var (
+		are = is.New(t)
+		dt  = map[string]struct {
+			in  string
+			out bool
+		}{
+			"Default":   {},
+			"Base":      {in: "fr", out: true},
+			"Region":    {in: "en-us", out: true},
+			"Upper":     {in: "EN"},
+			"Hyphen":    {in: "en-"},
+			"Separator": {in: "en_us"},
+		}
+	)
+	for name, tt := range dt {
+		tt := tt
+		t.Run(name, func(t *testing.T) {
+			are.Equal(tt.out, isLang(tt.in))
+		})
+	}
+}
+
+func TestCheckLabels(t *testing.T) {
+	var (
+		are = is.New(t)
+		dt  = map[string]struct {
+			in  []Enum
+			err bool
+		}{
+			"Default": {},
+			"Translated": {
+				in: []Enum{
+					{RawText: "a", Labels: map[string]string{"en": "A", "fr": "A"}},
+					{RawText: "b", Labels: map[string]string{"en": "B", "fr": "Bé"}},
+				},
+			},
+			"Duplicate": {
+				in: []Enum{
+					{RawText: "a", Labels: map[string]string{"en": "A"}},
+					{RawText: "b", Labels: map[string]string{"en": "A"}},
+				},
+				err: true,
+			},
+			"Fallback": {
+				in: []Enum{
+					{RawText: "a", Labels: map[string]string{"en": "A"}},
+					{RawText: "b", Labels: map[string]string{"en": "B", "fr": "A"}},
+				},
+				err: true,
+			},
+		}
+	)
+	for name, tt := range dt {
+		tt := tt
+		t.Run(name, func(t *testing.T) {
+			err := checkLabels(tt.in, []string{"en", "fr"})
+			are.Equal(tt.err, err != nil)
+		})
+	}
+}
+
+func TestCheckMetadata(t *testing.T) {
+	var (
+		are = is.New(t)
+		dt  = map[string]struct {
+			// inputs
+			data string
+			// outputs
+			err   error
+			names []string
+		}{
+			"Default": {data: "name,value,code:int\na,1,10\nb,2,20\n"},
+			"Same":    {data: "name,value,code:int\na,1,10\nb,1,10\n"},
+			"Unnamed": {data: "name,value,code:int\na,1,10\n_,1,20\n"},
+			"Conflict": {
+				data:  "name,value,code:int,slug:string\na,1,10,x\nb,2,20,y\nc,1,10,z\n",
+				err:   ErrInvalid,
+				names: []string{`"c"`, `"a"`, `"slug"`},
+			},
+			"Blank": {
+				data:  "name,value,retryable:bool\na,1,true\nb,1,\n",
+				err:   ErrInvalid,
+				names: []string{`"b"`, `"a"`, `"retryable"`},
+			},
+		}
+	)
+	for name, tt := range dt {
+		tt := tt
+		t.Run(name, func(t *testing.T) {
+			g := new(Generator)
+			err := ParseEnums(strings.NewReader(tt.data), "T", Int, false, false, false, true)(g)
+			are.NoErr(err) // unexpected parsing error
+			err = PrintMetadata("T")(g)
+			are.True(errors.Is(err, tt.err)) // mismatch error
+			for _, s := range tt.names {
+				are.True(strings.Contains(err.Error(), s)) // missing name
+			}
+		})
+	}
+}
+
+func TestEnumRuns(t *testing.T) {
+	var (
+		are = is.New(t)
+		dt  = map[string]struct {
+			in  []Enum
+			out [][]string
+		}{
+			"Default": {},
+			"String": {
+				in: []Enum{{Text: "A", Value: `"a"`, Kind: String}},
+			},
+			"Contiguous": {
+				in:  []Enum{{Text: "B", Value: "2"}, {Text: "A", Value: "1"}, {Text: "C", Value: "3"}},
+				out: [][]string{{"A", "B", "C"}},
+			},
+			"Signed": {
+				in: []Enum{
+					{Text: "A", Value: "-2"}, {Text: "B", Value: "-1"}, {Text: "C", Value: "0"}, {Text: "D", Value: "4"},
+				},
+				out: [][]string{{"A", "B", "C"}, {"D"}},
+			},
+			"Retired": {
+				in:  []Enum{{Text: "A", Value: "0"}, {Text: unnamed, Value: "1", Retired: true}, {Text: "C", Value: "2"}},
+				out: [][]string{{"A"}, {"C"}},
+			},
+			"Unsigned": {
+				in: []Enum{
+					{Text: "A", Value: "0", Kind: Uint8}, {Text: "B", Value: "255", Kind: Uint8},
+					{Text: "C", Value: "254", Kind: Uint8},
+				},
+				out: [][]string{{"A"}, {"C", "B"}},
+			},
+		}
+	)
+	for name, tt := range dt {
+		tt := tt
+		t.Run(name, func(t *testing.T) {
+			var out [][]string
+			for _, r := range enumRuns(tt.in) {
+				names := make([]string, len(r))
+				for k, e := range r {
+					names[k] = e.Text
+				}
+				out = append(out, names)
+			}
+			are.Equal(tt.out, out)
+		})
+	}
+}
+
+func TestConcatFormat(t *testing.T) {
+	var (
+		are = is.New(t)
+		dt  = map[string]struct {
+			// inputs
+			format string
+			kind   Kind
+			// outputs
+			out    string
+			failed bool
+		}{
+			"Default": {out: `""`},
+			"Name":    {format: "%[1]s", out: "s"},
+			"Unknown": {format: "%[3]s(%[2]d)", out: `"Enum(" + strconv.FormatInt(int64(e), 10) + ")"`},
+			"Sequential": {
+				format: "%s=%v",
+				kind:   Uint8,
+				out:    `s + "=" + strconv.FormatUint(uint64(e), 10)`,
+			},
+			"Quoted":    {format: "%[1]q 100%% %[3]q", out: `strconv.Quote(s) + " 100% \"Enum\""`},
+			"String":    {format: "%[2]q", kind: String, out: "strconv.Quote(string(e))"},
+			"Float":     {format: "%[2]f", kind: Float32, out: "strconv.FormatFloat(float64(e), 'f', 6, 32)"},
+			"Width":     {format: "%[1]5s", failed: true},
+			"Int float": {format: "%[2]d", kind: Float64, failed: true},
+			"Index":     {format: "%[a]s", failed: true},
+			"Trailing":  {format: "%[1]", failed: true},
+		}
+	)
+	for name, tt := range dt {
+		tt := tt
+		t.Run(name, func(t *testing.T) {
+			out, err := concatFormat(tt.format, strName, DefaultType, tt.kind)
+			are.Equal(tt.failed, err != nil) // unexpected error
+			are.Equal(tt.out, out)           // mismatch out
+		})
+	}
+}
+
+func TestReadCSV_Metadata(t *testing.T) {
+	var (
+		are = is.New(t)
+		dt  = map[string]struct {
+			header string
+			out    []string
+			failed bool
+		}{
+			"Default": {header: "name,value"},
+			"Typed": {
+				header: "name,retryable:bool,status:int,category:string",
+				out:    []string{"retryable:bool", "status:int", "category:string"},
+			},
+			"Upper":      {header: "name, Status : INT", out: []string{"Status:int"}},
+			"Unknown":    {header: "name,status:int128", failed: true},
+			"Invalid":    {header: "name,http-status:int", failed: true},
+			"Reserved":   {header: "name,string:string", failed: true},
+			"Duplicate":  {header: "name,status:int,Status:string", failed: true},
+			"Label":      {header: "name,label:fr", out: nil},
+			"ParseLabel": {header: "name,label:en,parse_label:int", failed: true},
+			"FlagValue":  {header: "name,flag_value:int", failed: true},
+			"Group":      {header: "name,group,group:string", failed: true},
+		}
+	)
+	for name, tt := range dt {
+		tt := tt
+		t.Run(name, func(t *testing.T) {
+			_, c, err := readCSV(strings.NewReader(tt.header), true)
+			are.Equal(tt.failed, err != nil) // unexpected error
+			if err != nil {
+				return
+			}
+			var out []string
+			for _, m := range c.metadata() {
+				out = append(out, m.String())
+			}
+			are.Equal(tt.out, out) // mismatch columns
+		})
+	}
+}
+
+func TestMetaColumn_Literal(t *testing.T) {
+	var (
+		are = is.New(t)
+		dt  = map[string]struct {
+			typ, in string
+			out     string
+			failed  bool
+		}{
+			"Default":      {typ: stringType, out: `""`},
+			"String":       {typ: stringType, in: `a"b`, out: `"a\"b"`},
+			"Empty bool":   {typ: boolType, out: "false"},
+			"Bool":         {typ: boolType, in: "1", out: "true"},
+			"Invalid bool": {typ: boolType, in: "yes", failed: true},
+			"Empty int":    {typ: "int", out: "0"},
+			"Int":          {typ: "int8", in: "+12", out: "12"},
+			"Overflow":     {typ: "int8", in: "128", failed: true},
+			"Unsigned":     {typ: "uint", in: "-1", failed: true},
+			"Float":        {typ: "float64", in: "1e3", out: "1000"},
+			"Infinity":     {typ: "float64", in: "Inf", failed: true},
+		}
+	)
+	for name, tt := range dt {
+		tt := tt
+		t.Run(name, func(t *testing.T) {
+			out, err := metaColumn{name: "x", typ: tt.typ}.literal(tt.in)
+			are.Equal(tt.failed, err != nil) // unexpected error
+			are.Equal(tt.out, out)           // mismatch literal
+		})
+	}
+}
+
+func TestEnumBlocks(t *testing.T) {
+	var (
+		are = is.New(t)
+		dt  = map[string]struct {
+			data   string
+			header bool
+			out    [][]string
+			groups []string
+		}{
+			"Default": {data: "a\nb", out: [][]string{{"A", "B"}}},
+			"Blank":   {data: "a\nb\n\n\nc", out: [][]string{{"A", "B"}, {"C"}}},
+			"Unnamed": {data: "a\n_\nb", out: [][]string{{"A", "_", "B"}}},
+			"Group": {
+				data:   "name,group\na,x\nb\nc,y\nd,x",
+				header: true,
+				out:    [][]string{{"A", "B"}, {"C"}, {"D"}},
+				groups: []string{"x", "y"},
+			},
+			"Group blank": {
+				data:   "name,group\na,x\n\nb\nc,y",
+				header: true,
+				out:    [][]string{{"A"}, {"B"}, {"C"}},
+				groups: []string{"x", "y"},
+			},
+		}
+	)
+	for name, tt := range dt {
+		tt := tt
+		t.Run(name, func(t *testing.T) {
+			g := new(Generator)
+			err := ParseEnums(strings.NewReader(tt.data), "T", Int, false, false, true, tt.header)(g)
+			are.NoErr(err) // unexpected error
+			var out [][]string
+			for _, b := range enumBlocks(g.enums) {
+				var s []string
+				for _, e := range b {
+					s = append(s, e.Text)
+				}
+				out = append(out, s)
+			}
+			are.Equal(tt.out, out)                    // mismatch blocks
+			are.Equal(tt.groups, enumGroups(g.enums)) // mismatch groups
+		})
+	}
+}
+
+func TestTextTable(t *testing.T) {
+	// enum returns an integer enum named after s, with its aliases.
+	enum := func(s, value string, aliases ...string) Enum {
+		return Enum{Kind: Int, Text: strings.ToUpper(s), RawText: s, Value: value, Aliases: aliases}
+	}
+	var (
+		are = is.New(t)
+		dt  = map[string]struct {
+			// inputs
+			enums  []Enum
+			format string
+			// outputs
+			keys   []string
+			lookup map[string]string
+			err    error
+		}{
+			"Default": {keys: []string{}},
+			"Sorted": {
+				enums:  []Enum{enum("b", "1"), enum("a", "2"), enum("c", "3")},
+				keys:   []string{"a", "b", "c"},
+				lookup: map[string]string{"a": "A", "b": "B", "c": "C", "": "", "d": "", "aa": ""},
+			},
+			"Bytes": {
+				enums:  []Enum{enum("été", "1"), enum("zeta", "2"), enum("Émile", "3"), enum("Zoo", "4")},
+				keys:   []string{"Zoo", "zeta", "Émile", "été"},
+				lookup: map[string]string{"été": "ÉTÉ", "Émile": "ÉMILE", "ete": "", "zoo": ""},
+			},
+			"Unnamed": {
+				enums: []Enum{enum("a", "1"), {Kind: Int, Text: unnamed, RawText: "b", Value: "2"}},
+				keys:  []string{"a"},
+			},
+			"Alias": {
+				enums:  []Enum{enum("colour", "1", "color", "Colour"), enum("grey", "2", "gray")},
+				keys:   []string{"Colour", "color", "colour", "gray", "grey"},
+				lookup: map[string]string{"color": "COLOUR", "Colour": "COLOUR", "gray": "GREY", "COLOR": ""},
+			},
+			"Same value": {
+				enums:  []Enum{enum("a", "1", "b"), enum("b", "1")},
+				keys:   []string{"a", "b"},
+				lookup: map[string]string{"b": "A"},
+			},
+			"Format": {
+				enums:  []Enum{enum("a", "1"), enum("b", "2")},
+				format: "%[1]s-%[2]d",
+				keys:   []string{"a-1", "b-2"},
+				lookup: map[string]string{"a-1": "A", "a": ""},
+			},
+			"Duplicate":       {enums: []Enum{enum("a", "1"), enum("a", "2")}, err: ErrInvalid},
+			"Alias collision": {enums: []Enum{enum("a", "1", "b"), enum("b", "2")}, err: ErrInvalid},
+			"Invalid value":   {enums: []Enum{enum("a", "rv")}, format: "%[2]d", err: strconv.ErrSyntax},
+		}
+	)
+	for name, tt := range dt {
+		tt := tt
+		t.Run(name, func(t *testing.T) {
+			if tt.format == "" {
+				tt.format = NameFormat()
+			}
+			keys, texts, err := textTable(tt.enums, tt.format)
+			are.True(errors.Is(err, tt.err)) // mismatch error
+			if err != nil {
+				return
+			}
+			are.Equal(tt.keys, keys)              // mismatch keys
+			are.True(sort.StringsAreSorted(keys)) // unsorted keys
+			for s, out := range tt.lookup {
+				// Same binary search as the generated parser.
+				var res string
+				if i := sort.SearchStrings(keys, s); i < len(keys) && keys[i] == s {
+					res = texts[s].Text
+				}
+				are.Equal(out, res) // mismatch lookup
+			}
+		})
+	}
+}
+
+// generate returns the source built by the configurators.
+func generate(opts ...Configurator) (string, error) {
+	g := new(Generator)
+	for _, opt := range opts {
+		err := opt(g)
+		if err != nil {
+			return "", err
+		}
+	}
+	return g.buf.String(), nil
+}
+
+func TestHandleDecoding(t *testing.T) {
+	var (
+		are = is.New(t)
+		dt  = map[string]struct {
+			// inputs
+			decoding Decoding
+			unknown  string
+			// outputs
+			out string // constant used as unknown value
+			err error
+		}{
+			"Default":         {},
+			"Closed":          {decoding: ClosedDecoding},
+			"Open":            {decoding: OpenDecoding},
+			"Open unknown":    {decoding: OpenDecoding, unknown: "unknown", out: "Unknown"},
+			"Open raw name":   {decoding: OpenDecoding, unknown: "not found", out: "NotFound"},
+			"Closed unknown":  {decoding: ClosedDecoding, unknown: "unknown", err: ErrInvalid},
+			"Missing unknown": {decoding: OpenDecoding, unknown: "oops", err: ErrMissing},
+			"Retired unknown": {decoding: OpenDecoding, unknown: "old", err: ErrMissing},
+		}
+	)
+	for name, tt := range dt {
+		tt := tt
+		t.Run(name, func(t *testing.T) {
+			const data = "name,value,retired\nunknown,0\nnot found,1\nold,2,true\na,3"
+			g := new(Generator)
+			err := ParseEnums(strings.NewReader(data), "T", Int, false, false, false, true)(g)
+			are.NoErr(err) // unexpected parse error
+			err = HandleDecoding(tt.decoding, tt.unknown)(g)
+			are.True(errors.Is(err, tt.err)) // mismatch error
+			if err != nil {
+				return
+			}
+			are.Equal(tt.decoding, g.decoding) // mismatch decoding
+			are.Equal(tt.out, g.unknown)       // mismatch unknown
+		})
+	}
+}
+
+func TestPrintSet(t *testing.T) {
+	var (
+		are = is.New(t)
+		dt  = map[string]struct {
+			// inputs
+			kind Kind
+			data string
+			// outputs
+			values string // constants sorted by value
+			set    string // field backing the set
+		}{
+			"Bitset":          {kind: Int, data: "a\nb\nc", values: "{A, B, C}", set: "bits [1]uint64"},
+			"Bitset sorted":   {kind: Uint8, data: "c,12\na,10\nb,11", values: "{A, B, C}", set: "bits [1]uint64"},
+			"Bitset negative": {kind: Int8, data: "a,-3\nb,2", values: "{A, B}", set: "bits [1]uint64"},
+			"Bitset words":    {kind: Int, data: "a,0\nb,64\nc,200", values: "{A, B, C}", set: "bits [4]uint64"},
+			"Bitset max span": {kind: Uint, data: "a,1\nb,256", values: "{A, B}", set: "bits [4]uint64"},
+			"Map span":        {kind: Uint, data: "a,0\nb,256", values: "{A, B}", set: "m map[T]struct{}"},
+			"Map string":      {kind: String, data: "b\na", values: "{A, B}", set: "m map[T]struct{}"},
+			"Map float":       {kind: Float64, data: "a,1.5\nb,0.5", values: "{B, A}", set: "m map[T]struct{}"},
+		}
+	)
+	for name, tt := range dt {
+		tt := tt
+		t.Run(name, func(t *testing.T) {
+			src, err := generate(
+				ParseEnums(strings.NewReader(tt.data), "T", tt.kind, false, false, tt.kind.IsInteger(), false),
+				PrintSet("T", tt.kind),
+			)
+			are.NoErr(err)                                                        // unexpected error
+			are.True(strings.Contains(src, "var _TSetValues = [...]T"+tt.values)) // mismatch sorted values
+			are.True(strings.Contains(src, "type TSet struct {\n"+tt.set+"\n}"))  // mismatch backing
+			are.True(!strings.Contains(src, "json"))                              // unexpected JSON methods
+		})
+	}
+}
+
+func TestParseTransitions(t *testing.T) {
+	const enums = "name,value,retired,aliases\npending,0\nactive,1,,running\nenabled,1\nclosed,2\nold,3,true"
+	var (
+		are = is.New(t)
+		dt  = map[string]struct {
+			// inputs
+			data io.Reader
+			// outputs
+			out map[string][]string
+			err error
+			msg string
+		}{
+			"Default": {data: strings.NewReader(""), out: map[string][]string{}},
+			"Header":  {data: strings.NewReader("from,to\npending,active"), out: map[string][]string{"Pending": {"Active"}}},
+			"Header case": {
+				data: strings.NewReader("From, TO\npending, active"),
+				out:  map[string][]string{"Pending": {"Active"}},
+			},
+			"No header": {
+				data: strings.NewReader("pending,active\nactive,closed\npending,closed"),
+				out:  map[string][]string{"Pending": {"Active", "Closed"}, "Active": {"Closed"}},
+			},
+			"Constants": {data: strings.NewReader("Pending,Active"), out: map[string][]string{"Pending": {"Active"}}},
+			"Duplicate": {
+				data: strings.NewReader("pending,active\npending,active\npending,enabled\nenabled,closed"),
+				out:  map[string][]string{"Pending": {"Active"}, "Active": {"Closed"}},
+			},
+			"Alias": {data: strings.NewReader("pending,running"), out: map[string][]string{"Pending": {"Active"}}},
+			"Retired": {
+				data: strings.NewReader("pending,active\npending,old"),
+				err:  ErrInvalid,
+				msg:  `line 2: unknown state "old"`,
+			},
+			"Unknown": {
+				data: strings.NewReader("from,to\noops,active"),
+				err:  ErrInvalid,
+				msg:  `line 2: unknown state "oops"`,
+			},
+			"Late header": {
+				data: strings.NewReader("pending,active\nfrom,to"),
+				err:  ErrInvalid,
+				msg:  `line 2: unknown state "from"`,
+			},
+			"Fields":  {data: strings.NewReader("pending,active,closed"), err: csv.ErrFieldCount},
+			"Missing": {err: ErrMissing},
+			"Unknown target": {
+				data: strings.NewReader("pending,oops"),
+				err:  ErrInvalid,
+				msg:  `line 1: unknown state "oops"`,
+			},
+		}
+	)
+	for name, tt := range dt {
+		tt := tt
+		t.Run(name, func(t *testing.T) {
+			g := new(Generator)
+			err := ParseEnums(strings.NewReader(enums), "T", Int, false, false, true, true)(g)
+			are.NoErr(err) // unexpected enums error
+			err = ParseTransitions(tt.data)(g)
+			are.True(errors.Is(err, tt.err))                              // mismatch error
+			are.True(err == nil || strings.Contains(err.Error(), tt.msg)) // mismatch message
+			if err != nil {
+				return
+			}
+			are.Equal(tt.out, g.transitions) // mismatch transitions
+		})
+	}
+}
+
+func TestPrintOrdered(t *testing.T) {
+	var (
+		are = is.New(t)
+		dt  = map[string]struct {
+			// inputs
+			kind  Kind
+			data  string
+			order Order
+			// outputs
+			values string
+			first  string
+			last   string
+			err    error
+		}{
+			"CSV order": {
+				kind: Int, data: "low,10\nurgent,40\nmedium,20\nhigh,30", order: DeclarationOrder,
+				values: "{Low, Urgent, Medium, High}", first: "Low", last: "High",
+			},
+			"Value order": {
+				kind: Int, data: "low,10\nurgent,40\nmedium,20\nhigh,30", order: ValueOrder,
+				values: "{Low, Medium, High, Urgent}", first: "Low", last: "Urgent",
+			},
+			"Sparse": {
+				kind: Int16, data: "a,300\nb,-5\nc,0", order: ValueOrder,
+				values: "{B, C, A}", first: "B", last: "A",
+			},
+			"Iota jumped": {
+				kind: Uint8, data: "c\n_\n_\na\nb", order: ValueOrder,
+				values: "{C, A, B}", first: "C", last: "B",
+			},
+			"Iota jumped CSV order": {
+				kind: Uint8, data: "c\n_\n_\na\nb", order: DeclarationOrder,
+				values: "{C, A, B}", first: "C", last: "B",
+			},
+			"Missing": {kind: Int, data: "_", order: ValueOrder, err: ErrMissing},
+		}
+	)
+	for name, tt := range dt {
+		tt := tt
+		t.Run(name, func(t *testing.T) {
+			src, err := generate(
+				ParseEnums(strings.NewReader(tt.data), "T", tt.kind, false, false, tt.kind.IsInteger(), false),
+				PrintOrdered("T", tt.order),
+			)
+			are.True(errors.Is(err, tt.err)) // mismatch error
+			if err != nil {
+				return
+			}
+			are.True(strings.Contains(src, "var _TOrder = [...]T"+tt.values))               // mismatch order
+			are.True(strings.Contains(src, "FirstT = "+tt.first+"\nLastT = "+tt.last+"\n")) // mismatch edges
+			for k, v := range strings.Split(strings.Trim(tt.values, "{}"), ", ") {
+				are.True(strings.Contains(src, "case "+v+":\nreturn "+strconv.Itoa(k)+"\n")) // mismatch position
+			}
+		})
+	}
+}
+
+func TestPrintFlagValue(t *testing.T) {
+	var (
+		are = is.New(t)
+		dt  = map[string]struct {
+			// inputs
+			data    string
+			bitmask bool
+			// outputs
+			usage string
+			recv  string // receiver of the flag methods
+		}{
+			"Default": {data: "name,value\nlow,1\nhigh,2", usage: `one of "low", "high"`, recv: "T"},
+			"Retired": {data: "name,value,retired\nlow,1\nmid,2,true\nhigh,3", usage: `one of "low", "high"`, recv: "T"},
+			"Bitmask": {
+				data:    "name\nread\nwrite\nexec",
+				bitmask: true,
+				usage:   `comma or pipe separated list of "read", "write", "exec"`,
+				recv:    "TValue",
+			},
+		}
+	)
+	for name, tt := range dt {
+		tt := tt
+		t.Run(name, func(t *testing.T) {
+			parse := ParseEnums(strings.NewReader(tt.data), "T", Int, false, false, false, true)
+			if tt.bitmask {
+				parse = ParseBitmask(strings.NewReader(tt.data), "T", false, false, true)
+			}
+			src, err := generate(parse, PrintFlagValue("T", tt.bitmask))
+			are.NoErr(err) // unexpected error
+			f, err := parser.ParseFile(token.NewFileSet(), "", "package test\n"+src, parser.SkipObjectResolution)
+			are.NoErr(err) // invalid source
+			var (
+				usage   string
+				methods []string
+			)
+			ast.Inspect(f, func(n ast.Node) bool {
+				switch n := n.(type) {
+				case *ast.ValueSpec:
+					if n.Names[0].Name == "TFlagUsage" {
+						usage, _ = strconv.Unquote(n.Values[0].(*ast.BasicLit).Value)
+					}
+				case *ast.FuncDecl:
+					methods = append(methods, receiverName(n.Recv)+"."+n.Name.Name)
+				}
+				return true
+			})
+			are.Equal(tt.usage, usage) // mismatch usage
+			want := []string{tt.recv + ".Set", tt.recv + ".Type"}
+			if tt.bitmask {
+				want = []string{"T.FlagValue", "TValue.Set", "TValue.String", "TValue.Type"}
+			}
+			sort.Strings(methods)
+			are.Equal(want, methods) // mismatch methods
+		})
+	}
+}
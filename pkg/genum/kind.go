@@ -131,6 +131,57 @@ func (k Kind) ValueFormat() string {
 	return fmt.Sprintf("%%[%d]%s", ValuePos, verb())
 }
 
+// binaryBytes returns the number of bytes used to encode a value of this Kind as binary.
+// Zero is returned for the string kind, without fixed size.
+func (k Kind) binaryBytes() int {
+	return k.BitSize() / bits8
+}
+
+// binaryFormat returns the statements returning the big-endian binary representation of the enum value.
+func binaryFormat(enumKind Kind) string {
+	if enumKind == String {
+		return fmt.Sprintf("return []byte(%s), nil\n", shortName)
+	}
+	src := shortName
+	if !enumKind.IsInteger() {
+		src = fmt.Sprintf("math.Float%[1]dbits(float%[1]d(%[2]s))", enumKind.BitSize(), shortName)
+	}
+	n := enumKind.binaryBytes()
+	p := make([]string, n)
+	for i := range p {
+		if shift := (n - i - 1) * bits8; shift > 0 {
+			p[i] = fmt.Sprintf("byte(%s >> %d)", mixedName, shift)
+		} else {
+			p[i] = fmt.Sprintf("byte(%s)", mixedName)
+		}
+	}
+	return fmt.Sprintf("%s := %s\nreturn []byte{%s}, nil\n", mixedName, src, strings.Join(p, ", "))
+}
+
+// binaryParse returns the expression converting the big-endian binary data to an enum value.
+func binaryParse(enumType string, enumKind Kind) string {
+	if enumKind == String {
+		return fmt.Sprintf("%s(%s)", enumType, srcName)
+	}
+	cast := enumType
+	if !enumKind.IsInteger() {
+		cast = fmt.Sprintf("uint%d", enumKind.BitSize())
+	}
+	n := enumKind.binaryBytes()
+	p := make([]string, n)
+	for i := range p {
+		if shift := (n - i - 1) * bits8; shift > 0 {
+			p[i] = fmt.Sprintf("%s(%s[%d])<<%d", cast, srcName, i, shift)
+		} else {
+			p[i] = fmt.Sprintf("%s(%s[%d])", cast, srcName, i)
+		}
+	}
+	if enumKind.IsInteger() {
+		return strings.Join(p, " | ")
+	}
+	return fmt.Sprintf("%s(math.Float%dfrombits(%s))", enumType, enumKind.BitSize(), strings.Join(p, " | "))
+}
+
 func strConvFormat(enumKind Kind) string {
 	switch {
 	case enumKind.IsInteger():
@@ -145,9 +196,69 @@ func strConvFormat(enumKind Kind) string {
 	}
 }
 
-func strConvParse(enumType string, enumKind Kind) string {
+// strConvValue returns the expression formatting the value as the %v verb, without the fmt package.
+func strConvValue(enumKind Kind, value string) string {
+	switch {
+	case enumKind.IsInteger():
+		if enumKind.IsSigned() {
+			return fmt.Sprintf("strconv.FormatInt(int64(%s), 10)", value)
+		}
+		return fmt.Sprintf("strconv.FormatUint(uint64(%s), 10)", value)
+	case enumKind.IsNumber():
+		return fmt.Sprintf("strconv.FormatFloat(float64(%s), 'g', -1, %d)", value, enumKind.BitSize())
+	default:
+		return stringOf(value)
+	}
+}
+
+// stringOf returns the value converted as string, except the string variable of the parsers.
+func stringOf(value string) string {
+	if value == strName {
+		return value
+	}
+	return "string(" + value + ")"
+}
+
+// jsonBufSize is the capacity of the buffer used to encode a number as JSON, enough for any integer.
+const jsonBufSize = 24
+
+// strConvAppendJSON returns the statements appending the enum value as JSON string to the b buffer.
+// Numbers are appended without allocation, as the strings without any character to escape.
+func strConvAppendJSON(enumKind Kind) string {
+	var stmt string
+	switch {
+	case enumKind.IsInteger():
+		if enumKind.IsSigned() {
+			stmt = "b = strconv.AppendInt(b, int64(%[1]s), 10)\n"
+		} else {
+			stmt = "b = strconv.AppendUint(b, uint64(%[1]s), 10)\n"
+		}
+	case enumKind.IsNumber():
+		stmt = "b = strconv.AppendFloat(b, float64(%[1]s), 'f', -1, 64)\n"
+	default:
+		return fmt.Sprintf(""+
+			"for i := 0; i < len(%[1]s); i++ {\n"+
+			"if c := %[1]s[i]; c < 0x20 || c >= 0x7f || c == '\"' || c == '\\\\' || c == '<' || c == '>' || c == '&' {\n"+
+			"data, err := json.Marshal(string(%[1]s))\n"+
+			"return append(b, data...), err\n"+
+			"}\n"+
+			"}\n"+
+			"b = append(b, '\"')\n"+
+			"b = append(b, %[1]s...)\n"+
+			"return append(b, '\"'), nil\n",
+			shortName,
+		)
+	}
+	return fmt.Sprintf("b = append(b, '\"')\n"+stmt+"return append(b, '\"'), nil\n", shortName)
+}
+
+// strConvParse returns the statements parsing the string as enum value.
+// The check function returns the statements to run on the enum value before its assignment.
+// The expects statement returns the error of a string not representing the enum kind.
+func strConvParse(enumType string, enumKind Kind, check func(enumType, value string) string, expects string) string {
 	if enumKind == String {
-		return fmt.Sprintf("*%s = %s(%s)\n", shortName, enumType, strName)
+		value := fmt.Sprintf("%s(%s)", enumType, strName)
+		return strings.ReplaceAll(check(enumType, value), "%", "%%") + fmt.Sprintf("*%s = %s\n", shortName, value)
 	}
 	method := func() string {
 		switch {
@@ -163,8 +274,9 @@ func strConvParse(enumType string, enumKind Kind) string {
 	var buf bytes.Buffer
 	_, _ = fmt.Fprintf(&buf, "%[3]s, err := "+method(), strName, enumKind.BitSize(), mixedName)
 	_, _ = fmt.Fprintf(&buf, "if err != nil {\n")
-	_, _ = fmt.Fprintf(&buf, returnErr, enumType, enumKind.Name(), strName)
+	_, _ = fmt.Fprintf(&buf, "%s", expects)
 	_, _ = fmt.Fprintf(&buf, "}\n")
-	_, _ = fmt.Fprintf(&buf, "*%s = %s(%s)\n", shortName, enumType, mixedName)
+	value := fmt.Sprintf("%s(%s)", enumType, mixedName)
+	_, _ = fmt.Fprintf(&buf, "%s*%s = %s\n", check(enumType, value), shortName, value)
 	return strings.ReplaceAll(buf.String(), "%", "%%")
 }
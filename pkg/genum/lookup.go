@@ -0,0 +1,45 @@
+// Copyright (c) 2021 Hervé Gouchet. All rights reserved.
+// Use of this source code is governed by the MIT License
+// that can be found in the LICENSE file.
+
+package genum
+
+import "strings"
+
+// Lookup represents the way to look up the name of an enum value.
+type Lookup uint8
+
+// List of supported lookups.
+const (
+	// AutoLookup chooses the most suitable lookup based on the enum values.
+	AutoLookup Lookup = iota
+	// ArrayLookup uses one array of names, indexed by the integer values.
+	ArrayLookup
+	// RunsLookup uses one array of names by run of consecutive integer values.
+	RunsLookup
+	// SwitchLookup uses a switch statement, human readable.
+	SwitchLookup
+	// MapLookup uses a map of names, indexed by the values.
+	MapLookup
+)
+
+var lookupNames = [...]string{"auto", "array", "runs", "switch", "map"}
+
+// LookupNamed converts s to a Lookup.
+func LookupNamed(s string) Lookup {
+	s = strings.ToLower(s)
+	for k, v := range lookupNames {
+		if v == s {
+			return Lookup(k)
+		}
+	}
+	return AutoLookup
+}
+
+// String implements the fmt.Stringer interface.
+func (l Lookup) String() string {
+	if int(l) < len(lookupNames) {
+		return lookupNames[l]
+	}
+	return lookupNames[AutoLookup]
+}
@@ -0,0 +1,37 @@
+// Copyright (c) 2021 Hervé Gouchet. All rights reserved.
+// Use of this source code is governed by the MIT License
+// that can be found in the LICENSE file.
+
+package genum_test
+
+import (
+	"testing"
+
+	"github.com/matryer/is"
+	"github.com/rvflash/genum/pkg/genum"
+)
+
+func TestLookupNamed(t *testing.T) {
+	t.Parallel()
+	var (
+		are = is.New(t)
+		dt  = map[string]struct {
+			in  string
+			out genum.Lookup
+		}{
+			"Default": {out: genum.AutoLookup},
+			"Unknown": {in: "tree", out: genum.AutoLookup},
+			"RUNS":    {in: "RUNS", out: genum.RunsLookup},
+			"map":     {in: "map", out: genum.MapLookup},
+		}
+	)
+	for name, tt := range dt {
+		tt := tt
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			out := genum.LookupNamed(tt.in)
+			are.Equal(out, tt.out)
+			are.Equal(genum.LookupNamed(out.String()), out) // mismatch round-trip
+		})
+	}
+}
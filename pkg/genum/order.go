@@ -0,0 +1,39 @@
+// Copyright (c) 2021 Hervé Gouchet. All rights reserved.
+// Use of this source code is governed by the MIT License
+// that can be found in the LICENSE file.
+
+package genum
+
+import "strings"
+
+// Order represents the order followed by the ordering methods of the enum.
+type Order uint8
+
+// List of supported orders.
+const (
+	// DeclarationOrder follows the order of the constants in the CSV source.
+	DeclarationOrder Order = iota
+	// ValueOrder follows the order of the constant values.
+	ValueOrder
+)
+
+var orderNames = [...]string{"csv", "value"}
+
+// OrderNamed converts s to an Order.
+func OrderNamed(s string) Order {
+	s = strings.ToLower(s)
+	for k, v := range orderNames {
+		if v == s {
+			return Order(k)
+		}
+	}
+	return DeclarationOrder
+}
+
+// String implements the fmt.Stringer interface.
+func (o Order) String() string {
+	if int(o) < len(orderNames) {
+		return orderNames[o]
+	}
+	return orderNames[DeclarationOrder]
+}
@@ -0,0 +1,37 @@
+// Copyright (c) 2021 Hervé Gouchet. All rights reserved.
+// Use of this source code is governed by the MIT License
+// that can be found in the LICENSE file.
+
+package genum_test
+
+import (
+	"testing"
+
+	"github.com/matryer/is"
+	"github.com/rvflash/genum/pkg/genum"
+)
+
+func TestOrderNamed(t *testing.T) {
+	t.Parallel()
+	var (
+		are = is.New(t)
+		dt  = map[string]struct {
+			in  string
+			out genum.Order
+		}{
+			"Default": {out: genum.DeclarationOrder},
+			"Unknown": {in: "name", out: genum.DeclarationOrder},
+			"CSV":     {in: "CSV", out: genum.DeclarationOrder},
+			"value":   {in: "value", out: genum.ValueOrder},
+		}
+	)
+	for name, tt := range dt {
+		tt := tt
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			out := genum.OrderNamed(tt.in)
+			are.Equal(out, tt.out)
+			are.Equal(genum.OrderNamed(out.String()), out) // mismatch round-trip
+		})
+	}
+}
@@ -0,0 +1,195 @@
+// Copyright (c) 2021 Hervé Gouchet. All rights reserved.
+// Use of this source code is governed by the MIT License
+// that can be found in the LICENSE file.
+
+package genum
+
+import (
+	"errors"
+	"fmt"
+	"go/ast"
+	"go/build"
+	"go/parser"
+	"go/token"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// Package describes the Go package declared in a directory.
+type Package struct {
+	// Name is the package name, empty without Go file.
+	Name string
+	// Decls lists the position of each top-level identifier declared by the package.
+	// Methods are named after their receiver type, as "T.Method".
+	Decls map[string]token.Position
+}
+
+// ParsePackage parses the Go files of the directory dir matching the build context, except the test files
+// and the excluded file names. A missing directory or a directory without Go file returns an empty package.
+func ParsePackage(dir string, exclude ...string) (*Package, error) {
+	p := &Package{Decls: make(map[string]token.Position)}
+	_, err := os.Stat(dir)
+	if errors.Is(err, fs.ErrNotExist) {
+		return p, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	files, err := packageFiles(dir, exclude)
+	if err != nil {
+		return nil, err
+	}
+	var (
+		fset  = token.NewFileSet()
+		names = make(map[string]bool, 1)
+	)
+	for _, path := range files {
+		f, err := parser.ParseFile(fset, path, nil, parser.PackageClauseOnly)
+		if err != nil {
+			return nil, err
+		}
+		names[f.Name.Name] = true
+	}
+	if len(names) > 1 {
+		list := make([]string, 0, len(names))
+		for name := range names {
+			list = append(list, name)
+		}
+		sort.Strings(list)
+		return nil, fmt.Errorf("multiple packages %s in %s: %w", strings.Join(list, ", "), dir, ErrInvalid)
+	}
+	for _, path := range files {
+		f, err := parser.ParseFile(fset, path, nil, parser.SkipObjectResolution)
+		if err != nil {
+			return nil, err
+		}
+		p.Name = f.Name.Name
+		for id, pos := range declarations(f) {
+			p.Decls[id] = fset.Position(pos)
+		}
+	}
+	return p, nil
+}
+
+// packageFiles returns the path of the Go files of the directory dir matching the build context,
+// except the test files and the excluded file names.
+func packageFiles(dir string, exclude []string) ([]string, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+	skip := make(map[string]bool, len(exclude))
+	for _, name := range exclude {
+		skip[name] = true
+	}
+	var res []string
+	for _, d := range entries {
+		name := d.Name()
+		if d.IsDir() || !strings.HasSuffix(name, goFileExt) || strings.HasSuffix(name, goTestFileExt) || skip[name] {
+			continue
+		}
+		ok, err := build.Default.MatchFile(dir, name)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			res = append(res, filepath.Join(dir, name))
+		}
+	}
+	return res, nil
+}
+
+const (
+	goFileExt     = ".go"
+	goTestFileExt = "_test.go"
+)
+
+// CheckPackage checks that the generated source can join the package declared in the directory dir,
+// without the excluded file names: same package name and no identifier declared twice.
+func CheckPackage(dir string, exclude ...string) Configurator {
+	return func(g *Generator) error {
+		if g.err != nil {
+			return g.err
+		}
+		f, err := parser.ParseFile(token.NewFileSet(), "", g.buf.Bytes(), parser.SkipObjectResolution)
+		if err != nil {
+			// Invalid source code is reported on writing.
+			return nil
+		}
+		p, err := ParsePackage(dir, exclude...)
+		if err != nil {
+			return fmt.Errorf("destination package: %w", err)
+		}
+		if p.Name != "" && p.Name != f.Name.Name {
+			return fmt.Errorf("package %s: %w: %s declares the package %s", f.Name.Name, ErrInvalid, dir, p.Name)
+		}
+		var clashes []string
+		for id := range declarations(f) {
+			if pos, ok := p.Decls[id]; ok {
+				clashes = append(clashes, id+" at "+pos.String())
+			}
+		}
+		if len(clashes) > 0 {
+			sort.Strings(clashes)
+			return fmt.Errorf("%w: already declared: %s", ErrInvalid, strings.Join(clashes, ", "))
+		}
+		return nil
+	}
+}
+
+// declarations returns the position of each top-level identifier declared in the file.
+func declarations(f *ast.File) map[string]token.Pos {
+	res := make(map[string]token.Pos)
+	add := func(id *ast.Ident, prefix string) {
+		if id.Name != "_" && id.Name != "init" {
+			res[prefix+id.Name] = id.Pos()
+		}
+	}
+	for _, d := range f.Decls {
+		switch d := d.(type) {
+		case *ast.FuncDecl:
+			if d.Recv == nil {
+				add(d.Name, "")
+			} else if recv := receiverName(d.Recv); recv != "" {
+				add(d.Name, recv+".")
+			}
+		case *ast.GenDecl:
+			for _, s := range d.Specs {
+				switch s := s.(type) {
+				case *ast.TypeSpec:
+					add(s.Name, "")
+				case *ast.ValueSpec:
+					for _, id := range s.Names {
+						add(id, "")
+					}
+				}
+			}
+		}
+	}
+	return res
+}
+
+// receiverName returns the type name of the method receiver, empty if unknown.
+func receiverName(recv *ast.FieldList) string {
+	if len(recv.List) == 0 {
+		return ""
+	}
+	t := recv.List[0].Type
+	for {
+		switch x := t.(type) {
+		case *ast.StarExpr:
+			t = x.X
+		case *ast.IndexExpr:
+			t = x.X
+		case *ast.IndexListExpr:
+			t = x.X
+		case *ast.Ident:
+			return x.Name
+		default:
+			return ""
+		}
+	}
+}
@@ -0,0 +1,123 @@
+// Copyright (c) 2021 Hervé Gouchet. All rights reserved.
+// Use of this source code is governed by the MIT License
+// that can be found in the LICENSE file.
+
+package genum_test
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/matryer/is"
+	"github.com/rvflash/genum/pkg/genum"
+)
+
+// packageDir returns a directory with the Go files of the color package.
+func packageDir(t *testing.T) string {
+	t.Helper()
+	var (
+		dir   = t.TempDir()
+		files = map[string]string{
+			"doc.go":        "package color\n\nconst Red = 1\n\ntype Shade int\n\nfunc (s *Shade) Set(string) error { return nil }\n\nfunc init() {}\n\nvar _ = Red\n",
+			"color_test.go": "package color_test\n\nconst Red = 2\n",
+			"ignored.go":    "//go:build ignore\n\npackage main\n",
+			"shade.go":      "package shade\n",
+		}
+	)
+	for name, src := range files {
+		err := os.WriteFile(filepath.Join(dir, name), []byte(src), 0600)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	// A directory is not a Go file, even with its extension.
+	err := os.Mkdir(filepath.Join(dir, "sub.go"), 0750)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return dir
+}
+
+func TestParsePackage(t *testing.T) {
+	t.Parallel()
+	var (
+		are    = is.New(t)
+		dir    = packageDir(t)
+		syntax = t.TempDir()
+		dt     = map[string]struct {
+			// inputs
+			dir     string
+			exclude []string
+			// outputs
+			name   string
+			decls  []string
+			failed bool
+		}{
+			"Default":  {decls: []string{}},
+			"Missing":  {dir: filepath.Join(dir, "oops"), decls: []string{}},
+			"Multiple": {dir: dir, failed: true},
+			"OK":       {dir: dir, exclude: []string{"shade.go"}, name: "color", decls: []string{"Red", "Shade", "Shade.Set"}},
+			"Syntax":   {dir: syntax, failed: true},
+		}
+	)
+	err := os.WriteFile(filepath.Join(syntax, "doc.go"), []byte("package color\n\nfunc {\n"), 0600)
+	are.NoErr(err) // unexpected write error
+	for name, tt := range dt {
+		tt := tt
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			p, err := genum.ParsePackage(tt.dir, tt.exclude...)
+			are.Equal(tt.failed, err != nil) // unexpected error
+			if err != nil {
+				return
+			}
+			decls := make([]string, 0, len(p.Decls))
+			for id := range p.Decls {
+				decls = append(decls, id)
+			}
+			sort.Strings(decls)
+			are.Equal(tt.name, p.Name) // mismatch name
+			are.Equal(tt.decls, decls) // mismatch declarations
+		})
+	}
+}
+
+func TestCheckPackage(t *testing.T) {
+	t.Parallel()
+	var (
+		are = is.New(t)
+		dir = packageDir(t)
+		dt  = map[string]struct {
+			// inputs
+			pkg      string
+			enumType string
+			data     string
+			// outputs
+			msg string
+		}{
+			"OK":      {pkg: "color", enumType: "Color", data: "Green\nBlue"},
+			"Package": {pkg: "shade", enumType: "Color", data: "Green", msg: "package shade"},
+			"Type":    {pkg: "color", enumType: "Shade", data: "Dark", msg: "already declared: Shade at "},
+			"Const":   {pkg: "color", enumType: "Color", data: "Green\nRed", msg: "already declared: Red at "},
+		}
+	)
+	for name, tt := range dt {
+		tt := tt
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			err := genum.Generate(
+				genum.ParseEnums(strings.NewReader(tt.data), tt.enumType, genum.Int, false, false, true, false),
+				genum.PrintHeader(tt.pkg, nil, nil),
+				genum.PrintEnums(tt.enumType, true, false),
+				genum.CheckPackage(dir, "shade.go"),
+			)
+			are.Equal(tt.msg != "", err != nil)                           // unexpected error
+			are.Equal(tt.msg != "", errors.Is(err, genum.ErrInvalid))     // expected invalid error
+			are.True(err == nil || strings.Contains(err.Error(), tt.msg)) // mismatch message
+		})
+	}
+}
@@ -0,0 +1,147 @@
+// Copyright (c) 2021 Hervé Gouchet. All rights reserved.
+// Use of this source code is governed by the MIT License
+// that can be found in the LICENSE file.
+
+// Package registry lists at runtime the enum types generated by genum with the register flag.
+package registry
+
+import (
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+)
+
+type errRegistry string
+
+// Error implements the error interface.
+func (e errRegistry) Error() string {
+	return string(e)
+}
+
+// ErrNotFound is returned when parsing a text with an unregistered enum type.
+const ErrNotFound = errRegistry("enum not found")
+
+var (
+	mu    sync.RWMutex
+	enums = make(map[string]*Enum)
+)
+
+// Enum describes a registered enum type.
+type Enum struct {
+	name   string
+	path   string
+	values []any
+	names  []string
+	parse  func(text string) (any, error)
+}
+
+// Name returns the name of the enum type, qualified by its package name, like "pkg.Status".
+func (e *Enum) Name() string {
+	if e == nil {
+		return ""
+	}
+	return e.name
+}
+
+// Path returns the import path of the package declaring the enum type.
+func (e *Enum) Path() string {
+	if e == nil {
+		return ""
+	}
+	return e.path
+}
+
+// Values returns the known constants of the enum type, in their declaration order.
+func (e *Enum) Values() []any {
+	if e == nil {
+		return nil
+	}
+	return append([]any(nil), e.values...)
+}
+
+// Names returns the names of the known constants, as returned by their String method, in the same order as the values.
+func (e *Enum) Names() []string {
+	if e == nil {
+		return nil
+	}
+	return append([]string(nil), e.names...)
+}
+
+// Parse returns the constant represented by the text, as parsed by the text unmarshaler of the enum type.
+// It returns ErrNotFound if the enum type is not registered.
+func (e *Enum) Parse(text string) (any, error) {
+	if e == nil {
+		return nil, ErrNotFound
+	}
+	return e.parse(text)
+}
+
+// Register registers the enum type of the zero value, with its known constants, their names and its parser.
+// It panics if the same enum type is registered twice or if the names do not match the values.
+// The zero value must be a named type, declared at the package level.
+func Register(zero any, values []any, names []string, parse func(text string) (any, error)) {
+	if zero == nil || parse == nil {
+		panic("registry: Register enum is nil")
+	}
+	if len(values) != len(names) {
+		panic("registry: Register enum with as many names as values")
+	}
+	t := reflect.TypeOf(zero)
+	e := &Enum{
+		name:   t.String(),
+		path:   t.PkgPath(),
+		values: values,
+		names:  names,
+		parse:  parse,
+	}
+	mu.Lock()
+	defer mu.Unlock()
+	if _, dup := enums[e.key()]; dup {
+		panic("registry: Register called twice for enum " + e.key())
+	}
+	enums[e.key()] = e
+}
+
+// key returns the name of the enum type qualified by the import path of its package.
+func (e *Enum) key() string {
+	if e.path == "" {
+		return e.name
+	}
+	return e.path + e.name[strings.IndexByte(e.name, '.'):]
+}
+
+// Lookup returns the enum type registered with this name, qualified by the import path of its package,
+// like "github.com/rvflash/pkg.Status", or only by its package name, like "pkg.Status".
+// With the package name, the first enum type by import path is returned. It returns nil if none matches.
+func Lookup(name string) *Enum {
+	mu.RLock()
+	defer mu.RUnlock()
+	if e, ok := enums[name]; ok {
+		return e
+	}
+	var res *Enum
+	for _, e := range enums {
+		if e.name == name && (res == nil || e.path < res.path) {
+			res = e
+		}
+	}
+	return res
+}
+
+// Enums returns the registered enum types, sorted by name then by import path.
+func Enums() []*Enum {
+	mu.RLock()
+	res := make([]*Enum, 0, len(enums))
+	for _, e := range enums {
+		res = append(res, e)
+	}
+	mu.RUnlock()
+	sort.Slice(res, func(i, j int) bool {
+		if res[i].name == res[j].name {
+			return res[i].path < res[j].path
+		}
+		return res[i].name < res[j].name
+	})
+	return res
+}
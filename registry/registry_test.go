@@ -0,0 +1,106 @@
+// Copyright (c) 2021 Hervé Gouchet. All rights reserved.
+// Use of this source code is governed by the MIT License
+// that can be found in the LICENSE file.
+
+package registry_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/matryer/is"
+	register_text "github.com/rvflash/genum/examples/register-text"
+	"github.com/rvflash/genum/registry"
+)
+
+const (
+	levelName  = "register_text.Level"
+	statusName = "register_text.Status"
+	statusPath = "github.com/rvflash/genum/examples/register-text"
+)
+
+func TestLookup(t *testing.T) {
+	t.Parallel()
+	var (
+		are = is.New(t)
+		dt  = map[string]struct {
+			// inputs
+			in string
+			// outputs
+			found bool
+		}{
+			"Default":   {},
+			"Unknown":   {in: "register_text.Color"},
+			"Name":      {in: statusName, found: true},
+			"Full name": {in: statusPath + ".Status", found: true},
+		}
+	)
+	for name, tt := range dt {
+		tt := tt
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			e := registry.Lookup(tt.in)
+			are.Equal(tt.found, e != nil) // mismatch found
+			if !tt.found {
+				are.Equal("", e.Name()) // unexpected name
+				return
+			}
+			are.Equal(statusName, e.Name())                                 // mismatch name
+			are.Equal(statusPath, e.Path())                                 // mismatch path
+			are.Equal([]string{"pending", "active", "archived"}, e.Names()) // mismatch names
+			are.Equal(
+				[]interface{}{register_text.Pending, register_text.Active, register_text.Archived},
+				e.Values(),
+			) // mismatch values
+		})
+	}
+}
+
+func TestEnum_Parse(t *testing.T) {
+	t.Parallel()
+	var (
+		are = is.New(t)
+		dt  = map[string]struct {
+			// inputs
+			name string
+			text string
+			// outputs
+			out interface{}
+			err error
+		}{
+			"Default": {err: registry.ErrNotFound},
+			"Unknown": {name: statusName, text: "oops", err: register_text.ErrInvalidStatus},
+			"OK":      {name: statusName, text: "active", out: register_text.Active},
+		}
+	)
+	for name, tt := range dt {
+		tt := tt
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			out, err := registry.Lookup(tt.name).Parse(tt.text)
+			are.True(errors.Is(err, tt.err)) // mismatch error
+			are.Equal(tt.out, out)           // mismatch out
+		})
+	}
+}
+
+func TestEnums(t *testing.T) {
+	t.Parallel()
+	var names []string
+	for _, e := range registry.Enums() {
+		names = append(names, e.Name())
+	}
+	is.New(t).Equal([]string{levelName, statusName}, names)
+}
+
+func TestRegister(t *testing.T) {
+	t.Parallel()
+	are := is.New(t)
+	parse := func(text string) (interface{}, error) {
+		return nil, nil
+	}
+	defer func() {
+		are.True(recover() != nil) // expected panic
+	}()
+	registry.Register(register_text.Status(0), nil, nil, parse)
+}
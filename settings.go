@@ -5,9 +5,11 @@
 package main
 
 import (
+	"fmt"
 	"io"
 	"os"
 	"path/filepath"
+	"strings"
 
 	"github.com/rvflash/genum/pkg/genum"
 	"github.com/rvflash/naming"
@@ -16,21 +18,44 @@ import (
 // Settings contains all the options exposed by Genum.
 type Settings struct {
 	srcFile        io.Reader
-	dstDir         string
+	output         string
 	packageName    string
 	enumType       string
 	enumKind       string
 	stringFormater string
 	stringer       bool
+	switchMax      int
 	bitmask        bool
+	binary         bool
+	closed         bool
 	comment        bool
+	deprecation    string
+	flagValue      bool
+	header         bool
 	joinPrefix     bool
+	lookup         string
+	register       bool
+	set            bool
+	transitions    string
+	ordered        bool
+	order          string
+	transFile      io.Reader
+	noFmt          bool
 	trimPrefix     bool
 	iota           bool
+	open           bool
+	graphQL        bool
+	graphQLSchema  string
 	textMarshaler  bool
 	jsonMarshaler  bool
 	xmlMarshaler   bool
+	tests          bool
+	unknown        string
+	yamlMarshaler  bool
+	yamlNode       bool
 	validator      bool
+	values         bool
+	explicit       map[string]bool
 }
 
 // Bitmask implements the genum.Settings interface.
@@ -38,22 +63,56 @@ func (s Settings) Bitmask() bool {
 	return s.bitmask
 }
 
+// BinaryMarshaler implements the genum.Settings interface.
+func (s Settings) BinaryMarshaler() bool {
+	return s.binary
+}
+
 // Commented implements the genum.Settings interface.
 func (s Settings) Commented() bool {
 	return s.comment
 }
 
+// Decoding implements the genum.Settings interface.
+// Closed decoding prevails, the unknown constant implies the open one.
+func (s Settings) Decoding() genum.Decoding {
+	switch {
+	case s.closed:
+		return genum.ClosedDecoding
+	case s.open || s.unknown != "":
+		return genum.OpenDecoding
+	default:
+		return genum.DefaultDecoding
+	}
+}
+
+// Deprecation implements the genum.Settings interface.
+func (s Settings) Deprecation() genum.Deprecation {
+	return genum.DeprecationNamed(s.deprecation)
+}
+
+// FlagValue implements the genum.Settings interface.
+func (s Settings) FlagValue() bool {
+	return s.flagValue
+}
+
 const goFileExt = ".go"
 
 // DstFilename implements the genum.Settings interface.
+// The output is either the Go file name or its directory, the file is then named after the enum type.
 func (s Settings) DstFilename() string {
 	if s.enumType == "" {
 		return ""
 	}
-	if s.dstDir == "" {
-		s.dstDir, _ = os.Getwd()
+	if s.output == "" {
+		s.output, _ = os.Getwd()
 	}
-	return filepath.Join(s.dstDir, naming.SnakeCase(s.enumType)+goFileExt)
+	if filepath.Ext(s.output) == goFileExt {
+		if fi, err := os.Stat(s.output); err != nil || !fi.IsDir() {
+			return s.output
+		}
+	}
+	return filepath.Join(s.output, naming.SnakeCase(s.enumType)+goFileExt)
 }
 
 // TypeKind implements the genum.Settings interface.
@@ -71,6 +130,21 @@ func (s Settings) JoinPrefix() bool {
 	return s.joinPrefix
 }
 
+// Set implements the genum.Settings interface.
+func (s Settings) Set() bool {
+	return s.set
+}
+
+// Register implements the genum.Settings interface.
+func (s Settings) Register() bool {
+	return s.register
+}
+
+// NoFmt implements the genum.Settings interface.
+func (s Settings) NoFmt() bool {
+	return s.noFmt
+}
+
 // Iota implements the genum.Settings interface.
 func (s Settings) Iota() bool {
 	return s.iota && s.TypeKind().IsInteger()
@@ -78,7 +152,27 @@ func (s Settings) Iota() bool {
 
 // Validator implements the genum.Settings interface.
 func (s Settings) Validator() bool {
-	return s.validator
+	return s.validator || s.values || s.set || s.transitions != ""
+}
+
+// Values implements the genum.Settings interface.
+func (s Settings) Values() bool {
+	return s.values
+}
+
+// GraphQLMarshaler implements the genum.Settings interface.
+func (s Settings) GraphQLMarshaler() bool {
+	return s.graphQL
+}
+
+// GraphQLSchema implements the genum.Settings interface.
+func (s Settings) GraphQLSchema() string {
+	return s.graphQLSchema
+}
+
+// Header implements the genum.Settings interface.
+func (s Settings) Header() bool {
+	return s.header
 }
 
 // JSONMarshaler implements the genum.Settings interface.
@@ -86,22 +180,115 @@ func (s Settings) JSONMarshaler() bool {
 	return s.jsonMarshaler
 }
 
+// Lookup implements the genum.Settings interface.
+func (s Settings) Lookup() genum.Lookup {
+	return genum.LookupNamed(s.lookup)
+}
+
+// Ordered implements the genum.Settings interface.
+func (s Settings) Ordered() bool {
+	return s.ordered
+}
+
+// Order implements the genum.Settings interface.
+func (s Settings) Order() genum.Order {
+	return genum.OrderNamed(s.order)
+}
+
 // PackageName implements the genum.Settings interface.
 func (s Settings) PackageName() string {
 	return naming.SnakeCase(s.packageName)
 }
 
-// ReadFrom allows to read from file path given as argument or the given reader.
+// Validate returns an error listing each contradictory or ignored flag and each unsupported value.
+func (s Settings) Validate() error {
+	var msg []string
+	report := func(format string, a ...interface{}) {
+		msg = append(msg, fmt.Sprintf(format, a...))
+	}
+	if s.enumKind != "" && !strings.EqualFold(s.TypeKind().Name(), s.enumKind) {
+		report("-type %q: unsupported base type", s.enumKind)
+	}
+	if s.lookup != "" && !strings.EqualFold(s.Lookup().String(), s.lookup) {
+		report("-lookup %q: unknown lookup", s.lookup)
+	}
+	if s.deprecation != "" && !strings.EqualFold(s.Deprecation().String(), s.deprecation) {
+		report("-deprecated %q: unknown policy", s.deprecation)
+	}
+	if s.order != "" && !strings.EqualFold(s.Order().String(), s.order) {
+		report("-order %q: unknown order", s.order)
+	}
+	if s.switchMax < 0 {
+		report("-switch_max %d: negative number", s.switchMax)
+	}
+	if s.joinPrefix && s.trimPrefix {
+		report("-prefix and -noprefix are exclusive")
+	}
+	if s.bitmask && s.explicit["type"] {
+		report("-bitmask overrides -type with an unsigned integer type")
+	}
+	if s.iota && s.explicit["iota"] && (s.bitmask || !s.TypeKind().IsInteger()) {
+		report("-iota requires an integer type, without -bitmask")
+	}
+	if s.bitmask && s.set {
+		report("-set and -bitmask are exclusive, a bitmask is already a set")
+	}
+	if s.bitmask && s.transitions != "" {
+		report("-transitions and -bitmask are exclusive")
+	}
+	if s.explicit["order"] && !s.ordered {
+		report("-order requires -ordered")
+	}
+	if s.closed && s.open {
+		report("-closed and -open are exclusive")
+	}
+	if s.closed && s.unknown != "" {
+		report("-unknown requires the open decoding, not -closed")
+	}
+	switch l := s.Lookup(); {
+	case (l == genum.ArrayLookup || l == genum.RunsLookup) && !s.bitmask && !s.TypeKind().IsInteger():
+		report("-lookup %s requires an integer type", l)
+	case l != genum.AutoLookup && s.explicit["switch_max"]:
+		report("-switch_max is only used by the auto lookup")
+	}
+	if s.explicit["stringer_format"] && !s.Stringer() {
+		report("-stringer_format requires -stringer")
+	}
+	if len(msg) == 0 {
+		return nil
+	}
+	return fmt.Errorf("settings: %w: %s", genum.ErrInvalid, strings.Join(msg, "; "))
+}
+
+// ReadFrom allows to read from file path given as argument or the given reader,
+// then from the transitions file if any.
+// On failure, the source file opened by it is closed and no source is kept.
 func (s *Settings) ReadFrom(args []string, reader io.Reader) (err error) {
-	if len(args) == 0 {
-		if reader == nil {
-			return io.ErrClosedPipe
+	var src *os.File
+	switch {
+	case len(args) > 0:
+		src, err = os.Open(args[0])
+		if err == nil {
+			s.srcFile = src
 		}
+	case reader == nil:
+		err = io.ErrClosedPipe
+	default:
 		s.srcFile = reader
+	}
+	if err != nil || s.transitions == "" {
 		return
 	}
-	s.srcFile, err = os.Open(args[0])
-	return
+	f, err := os.Open(s.transitions)
+	if err != nil {
+		if src != nil {
+			_ = src.Close()
+		}
+		s.srcFile = nil
+		return err
+	}
+	s.transFile = f
+	return nil
 }
 
 // SrcFile implements the genum.Settings interface.
@@ -111,7 +298,7 @@ func (s Settings) SrcFile() io.Reader {
 
 // Stringer implements the genum.Settings interface.
 func (s Settings) Stringer() bool {
-	return s.stringer || s.textMarshaler
+	return s.stringer || s.values || s.set || s.TextMarshaler()
 }
 
 // StringFormater implements the genum.Settings interface.
@@ -119,9 +306,37 @@ func (s Settings) StringFormater() string {
 	return s.stringFormater
 }
 
+const goTestFileSuffix = "_test"
+
+// SwitchMax implements the genum.Settings interface.
+// Without the -switch_max flag, it returns the default maximum, an explicit 0 disables the switch statement.
+func (s Settings) SwitchMax() int {
+	if s.switchMax == 0 && !s.explicit["switch_max"] {
+		return genum.DefaultSwitchMax
+	}
+	return s.switchMax
+}
+
+// TestFilename implements the genum.Settings interface.
+func (s Settings) TestFilename() string {
+	if !s.tests {
+		return ""
+	}
+	dst := s.DstFilename()
+	if dst == "" {
+		return ""
+	}
+	return strings.TrimSuffix(dst, goFileExt) + goTestFileSuffix + goFileExt
+}
+
+// Transitions implements the genum.Settings interface.
+func (s Settings) Transitions() io.Reader {
+	return s.transFile
+}
+
 // TextMarshaler implements the genum.Settings interface.
 func (s Settings) TextMarshaler() bool {
-	return s.textMarshaler
+	return s.textMarshaler || s.flagValue || s.graphQL || s.register || s.YAMLMarshaler()
 }
 
 // TrimPrefix implements the genum.Settings interface.
@@ -129,7 +344,22 @@ func (s Settings) TrimPrefix() bool {
 	return s.trimPrefix
 }
 
+// Unknown implements the genum.Settings interface.
+func (s Settings) Unknown() string {
+	return s.unknown
+}
+
 // XMLMarshaler implements the genum.Settings interface.
 func (s Settings) XMLMarshaler() bool {
 	return s.xmlMarshaler
 }
+
+// YAMLMarshaler implements the genum.Settings interface.
+func (s Settings) YAMLMarshaler() bool {
+	return s.yamlMarshaler || s.yamlNode
+}
+
+// YAMLNode implements the genum.Settings interface.
+func (s Settings) YAMLNode() bool {
+	return s.yamlNode
+}
@@ -5,6 +5,7 @@
 package main
 
 import (
+	"errors"
 	"io"
 	"strings"
 	"testing"
@@ -16,6 +17,7 @@ import (
 const (
 	pkg    = "test"
 	format = "format"
+	schema = "schema.graphql"
 )
 
 func TestSettings_ReadFrom(t *testing.T) {
@@ -37,6 +39,20 @@ func TestSettings_ReadFrom(t *testing.T) {
 			},
 			"Stdin": {reader: strings.NewReader("csv")},
 			"File":  {args: []string{"testdata/hello.csv"}},
+			"Transitions": {
+				opts: Settings{transitions: "testdata/transitions.csv"},
+				args: []string{"testdata/hello.csv"},
+			},
+			"Transitions not found": {
+				opts:   Settings{transitions: "testdata/oops.csv"},
+				args:   []string{"testdata/hello.csv"},
+				failed: true,
+			},
+			"Stdin without transitions": {
+				opts:   Settings{transitions: "testdata/oops.csv"},
+				reader: strings.NewReader("csv"),
+				failed: true,
+			},
 		}
 	)
 	for name, tt := range dt {
@@ -44,7 +60,82 @@ func TestSettings_ReadFrom(t *testing.T) {
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
 			err := tt.opts.ReadFrom(tt.args, tt.reader)
-			are.Equal(err != nil, tt.failed) // unexpected error
+			are.Equal(err != nil, tt.failed)                                                 // unexpected error
+			are.Equal(tt.opts.transitions != "" && !tt.failed, tt.opts.Transitions() != nil) // mismatch transitions
+			are.Equal(!tt.failed, tt.opts.SrcFile() != nil)                                  // mismatch source
+		})
+	}
+}
+
+func TestSettings_Validate(t *testing.T) {
+	t.Parallel()
+	var (
+		are = is.New(t)
+		dt  = map[string]struct {
+			// inputs
+			opts Settings
+			// outputs
+			msg string
+		}{
+			"Default": {},
+			"Valid": {
+				opts: Settings{
+					enumKind: "Uint8", lookup: "array", deprecation: "warn", iota: true, explicit: map[string]bool{"iota": true},
+				},
+			},
+			"Unknown type":   {opts: Settings{enumKind: "int128"}, msg: `-type "int128": unsupported base type`},
+			"Unknown lookup": {opts: Settings{lookup: "tree"}, msg: `-lookup "tree": unknown lookup`},
+			"Unknown order":  {opts: Settings{ordered: true, order: "name"}, msg: `-order "name": unknown order`},
+			"Unordered": {
+				opts: Settings{order: "value", explicit: map[string]bool{"order": true}},
+				msg:  "-order requires -ordered",
+			},
+			"Unknown policy":  {opts: Settings{deprecation: "drop"}, msg: `-deprecated "drop": unknown policy`},
+			"Negative switch": {opts: Settings{switchMax: -1}, msg: "-switch_max -1: negative number"},
+			"Prefix":          {opts: Settings{joinPrefix: true, trimPrefix: true}, msg: "-prefix and -noprefix are exclusive"},
+			"Bitmask set":     {opts: Settings{bitmask: true, set: true}, msg: "-set and -bitmask are exclusive"},
+			"Bitmask transitions": {
+				opts: Settings{bitmask: true, transitions: "transitions.csv"},
+				msg:  "-transitions and -bitmask are exclusive",
+			},
+			"Closed and open":    {opts: Settings{closed: true, open: true}, msg: "-closed and -open are exclusive"},
+			"Closed and unknown": {opts: Settings{closed: true, unknown: "x"}, msg: "-unknown requires the open decoding"},
+			"Bitmask type": {
+				opts: Settings{bitmask: true, enumKind: "int", explicit: map[string]bool{"bitmask": true, "type": true}},
+				msg:  "-bitmask overrides -type",
+			},
+			"Bitmask iota": {
+				opts: Settings{bitmask: true, iota: true, explicit: map[string]bool{"bitmask": true, "iota": true}},
+				msg:  "-iota requires an integer type",
+			},
+			"Float iota": {
+				opts: Settings{enumKind: "float64", iota: true, explicit: map[string]bool{"iota": true}},
+				msg:  "-iota requires an integer type",
+			},
+			"Default iota": {opts: Settings{enumKind: "string", iota: true}},
+			"String array": {opts: Settings{enumKind: "string", lookup: "array"}, msg: "-lookup array requires an integer type"},
+			"Switch max": {
+				opts: Settings{lookup: "map", switchMax: 3, explicit: map[string]bool{"switch_max": true}},
+				msg:  "-switch_max is only used by the auto lookup",
+			},
+			"Stringer format": {
+				opts: Settings{stringFormater: format, explicit: map[string]bool{"stringer_format": true}},
+				msg:  "-stringer_format requires -stringer",
+			},
+			"Many": {
+				opts: Settings{enumKind: "int128", joinPrefix: true, trimPrefix: true},
+				msg:  `-type "int128": unsupported base type; -prefix and -noprefix are exclusive`,
+			},
+		}
+	)
+	for name, tt := range dt {
+		tt := tt
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			err := tt.opts.Validate()
+			are.Equal(tt.msg != "", err != nil)                           // unexpected error
+			are.Equal(tt.msg != "", errors.Is(err, genum.ErrInvalid))     // expected invalid error
+			are.True(err == nil || strings.Contains(err.Error(), tt.msg)) // mismatch message
 		})
 	}
 }
@@ -69,68 +160,203 @@ func TestSettings(t *testing.T) {
 			// inputs
 			opts Settings
 			// outputs
-			dstDir         string
+			dstFilename    string
+			testFilename   string
 			packageName    string
 			enumType       string
 			enumKind       genum.Kind
 			stringFormater string
 			stringer       bool
+			switchMax      int
 			bitmask        bool
+			binary         bool
 			comment        bool
+			decoding       genum.Decoding
+			deprecation    genum.Deprecation
+			flagValue      bool
+			header         bool
 			joinPrefix     bool
+			lookup         genum.Lookup
+			register       bool
+			set            bool
+			ordered        bool
+			order          genum.Order
+			noFmt          bool
 			trimPrefix     bool
 			iota           bool
+			graphQL        bool
+			graphQLSchema  string
 			textMarshaler  bool
 			jsonMarshaler  bool
 			xmlMarshaler   bool
+			yamlMarshaler  bool
+			yamlNode       bool
+			unknown        string
 			validator      bool
+			values         bool
 		}{
-			"Default": {enumKind: genum.Int},
+			"Default": {enumKind: genum.Int, switchMax: genum.DefaultSwitchMax},
 			"Text marshal only": {
 				opts:          Settings{textMarshaler: true},
 				enumKind:      genum.Int,
+				switchMax:     genum.DefaultSwitchMax,
+				stringer:      true,
+				textMarshaler: true,
+			},
+			"Flag only": {
+				opts:          Settings{flagValue: true},
+				enumKind:      genum.Int,
+				switchMax:     genum.DefaultSwitchMax,
+				stringer:      true,
+				textMarshaler: true,
+				flagValue:     true,
+			},
+			"GraphQL only": {
+				opts:          Settings{graphQL: true},
+				enumKind:      genum.Int,
+				switchMax:     genum.DefaultSwitchMax,
+				stringer:      true,
+				textMarshaler: true,
+				graphQL:       true,
+			},
+			"YAML node": {
+				opts:          Settings{yamlNode: true},
+				enumKind:      genum.Int,
+				switchMax:     genum.DefaultSwitchMax,
+				stringer:      true,
+				textMarshaler: true,
+				yamlMarshaler: true,
+				yamlNode:      true,
+			},
+			"Open": {
+				opts:      Settings{open: true},
+				enumKind:  genum.Int,
+				switchMax: genum.DefaultSwitchMax,
+				decoding:  genum.OpenDecoding,
+			},
+			"Unknown": {
+				opts:      Settings{unknown: "unknown"},
+				enumKind:  genum.Int,
+				switchMax: genum.DefaultSwitchMax,
+				decoding:  genum.OpenDecoding,
+				unknown:   "unknown",
+			},
+			"Values only": {
+				opts:      Settings{values: true},
+				enumKind:  genum.Int,
+				switchMax: genum.DefaultSwitchMax,
+				stringer:  true,
+				validator: true,
+				values:    true,
+			},
+			"Ordered only": {
+				opts:      Settings{ordered: true, order: "VALUE"},
+				enumKind:  genum.Int,
+				switchMax: genum.DefaultSwitchMax,
+				ordered:   true,
+				order:     genum.ValueOrder,
+			},
+			"Set only": {
+				opts:      Settings{set: true},
+				enumKind:  genum.Int,
+				switchMax: genum.DefaultSwitchMax,
+				set:       true,
+				stringer:  true,
+				validator: true,
+			},
+			"Register only": {
+				opts:          Settings{register: true},
+				enumKind:      genum.Int,
+				switchMax:     genum.DefaultSwitchMax,
+				register:      true,
 				stringer:      true,
 				textMarshaler: true,
 			},
+			"Transitions only": {
+				opts:      Settings{transitions: "transitions.csv"},
+				enumKind:  genum.Int,
+				switchMax: genum.DefaultSwitchMax,
+				validator: true,
+			},
 			"String only": {
-				opts:     Settings{stringer: true},
+				opts:      Settings{stringer: true},
+				enumKind:  genum.Int,
+				switchMax: genum.DefaultSwitchMax,
+				stringer:  true,
+			},
+			"Switch disabled": {
+				opts:     Settings{explicit: map[string]bool{"switch_max": true}},
 				enumKind: genum.Int,
-				stringer: true,
 			},
 			"Complete": {
 				opts: Settings{
 					srcFile:        nil,
-					dstDir:         "",
+					output:         "",
 					packageName:    pkg,
 					enumType:       genum.DefaultType,
 					enumKind:       genum.Uint.Name(),
 					stringFormater: format,
 					stringer:       true,
 					bitmask:        true,
+					binary:         true,
 					comment:        true,
+					closed:         true,
+					open:           true,
+					deprecation:    "REJECT",
+					flagValue:      true,
+					header:         true,
 					joinPrefix:     true,
+					lookup:         "Runs",
+					register:       true,
+					set:            true,
+					ordered:        true,
+					noFmt:          true,
+					switchMax:      4,
 					trimPrefix:     true,
 					iota:           true,
+					graphQL:        true,
+					graphQLSchema:  schema,
 					textMarshaler:  true,
 					jsonMarshaler:  true,
 					xmlMarshaler:   true,
+					tests:          true,
+					yamlMarshaler:  true,
+					yamlNode:       true,
 					validator:      true,
+					values:         true,
 				},
-				dstDir:         strings.ToLower(genum.DefaultType) + ".go",
+				dstFilename:    strings.ToLower(genum.DefaultType) + ".go",
+				testFilename:   strings.ToLower(genum.DefaultType) + "_test.go",
 				packageName:    pkg,
 				enumKind:       genum.Uint,
 				enumType:       genum.DefaultType,
 				stringFormater: format,
 				stringer:       true,
 				bitmask:        true,
+				binary:         true,
 				comment:        true,
+				decoding:       genum.ClosedDecoding,
+				deprecation:    genum.RejectDeprecated,
+				flagValue:      true,
+				header:         true,
 				joinPrefix:     true,
+				lookup:         genum.RunsLookup,
+				register:       true,
+				set:            true,
+				ordered:        true,
+				noFmt:          true,
+				switchMax:      4,
 				trimPrefix:     true,
 				iota:           true,
+				graphQL:        true,
+				graphQLSchema:  schema,
 				textMarshaler:  true,
 				jsonMarshaler:  true,
 				xmlMarshaler:   true,
+				yamlMarshaler:  true,
+				yamlNode:       true,
 				validator:      true,
+				values:         true,
 			},
 		}
 	)
@@ -138,21 +364,41 @@ func TestSettings(t *testing.T) {
 		tt := tt
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
-			are.True(strings.HasSuffix(tt.opts.DstFilename(), tt.dstDir)) // mismatch dstDir
-			are.Equal(tt.packageName, tt.opts.PackageName())              // mismatch packageName
-			are.Equal(tt.enumType, tt.opts.TypeName())                    // mismatch enumType
-			are.Equal(tt.enumKind, tt.opts.TypeKind())                    // mismatch enumKind
-			are.Equal(tt.stringFormater, tt.opts.StringFormater())        // mismatch stringFormater
-			are.Equal(tt.bitmask, tt.opts.Bitmask())                      // mismatch bitmask
-			are.Equal(tt.comment, tt.opts.Commented())                    // mismatch comment
-			are.Equal(tt.joinPrefix, tt.opts.JoinPrefix())                // mismatch joinPrefix
-			are.Equal(tt.trimPrefix, tt.opts.TrimPrefix())                // mismatch trimPrefix
-			are.Equal(tt.iota, tt.opts.Iota())                            // mismatch iota
-			are.Equal(tt.stringer, tt.opts.Stringer())                    // mismatch stringer
-			are.Equal(tt.textMarshaler, tt.opts.TextMarshaler())          // mismatch textMarshaler
-			are.Equal(tt.jsonMarshaler, tt.opts.JSONMarshaler())          // mismatch jsonMarshaler
-			are.Equal(tt.xmlMarshaler, tt.opts.XMLMarshaler())            // mismatch xmlMarshaler
-			are.Equal(tt.validator, tt.opts.Validator())                  // mismatch validator
+			are.True(strings.HasSuffix(tt.opts.DstFilename(), tt.dstFilename))   // mismatch dstFilename
+			are.True(strings.HasSuffix(tt.opts.TestFilename(), tt.testFilename)) // mismatch testFilename
+			are.Equal(tt.testFilename == "", tt.opts.TestFilename() == "")       // mismatch tests
+			are.Equal(tt.packageName, tt.opts.PackageName())                     // mismatch packageName
+			are.Equal(tt.enumType, tt.opts.TypeName())                           // mismatch enumType
+			are.Equal(tt.enumKind, tt.opts.TypeKind())                           // mismatch enumKind
+			are.Equal(tt.stringFormater, tt.opts.StringFormater())               // mismatch stringFormater
+			are.Equal(tt.bitmask, tt.opts.Bitmask())                             // mismatch bitmask
+			are.Equal(tt.binary, tt.opts.BinaryMarshaler())                      // mismatch binary
+			are.Equal(tt.comment, tt.opts.Commented())                           // mismatch comment
+			are.Equal(tt.decoding, tt.opts.Decoding())                           // mismatch decoding
+			are.Equal(tt.deprecation, tt.opts.Deprecation())                     // mismatch deprecation
+			are.Equal(tt.flagValue, tt.opts.FlagValue())                         // mismatch flagValue
+			are.Equal(tt.header, tt.opts.Header())                               // mismatch header
+			are.Equal(tt.lookup, tt.opts.Lookup())                               // mismatch lookup
+			are.Equal(tt.noFmt, tt.opts.NoFmt())                                 // mismatch noFmt
+			are.Equal(tt.register, tt.opts.Register())                           // mismatch register
+			are.Equal(tt.set, tt.opts.Set())                                     // mismatch set
+			are.Equal(tt.ordered, tt.opts.Ordered())                             // mismatch ordered
+			are.Equal(tt.order, tt.opts.Order())                                 // mismatch order
+			are.Equal(tt.switchMax, tt.opts.SwitchMax())                         // mismatch switchMax
+			are.Equal(tt.joinPrefix, tt.opts.JoinPrefix())                       // mismatch joinPrefix
+			are.Equal(tt.trimPrefix, tt.opts.TrimPrefix())                       // mismatch trimPrefix
+			are.Equal(tt.iota, tt.opts.Iota())                                   // mismatch iota
+			are.Equal(tt.graphQL, tt.opts.GraphQLMarshaler())                    // mismatch graphQL
+			are.Equal(tt.graphQLSchema, tt.opts.GraphQLSchema())                 // mismatch graphQLSchema
+			are.Equal(tt.stringer, tt.opts.Stringer())                           // mismatch stringer
+			are.Equal(tt.textMarshaler, tt.opts.TextMarshaler())                 // mismatch textMarshaler
+			are.Equal(tt.jsonMarshaler, tt.opts.JSONMarshaler())                 // mismatch jsonMarshaler
+			are.Equal(tt.xmlMarshaler, tt.opts.XMLMarshaler())                   // mismatch xmlMarshaler
+			are.Equal(tt.yamlMarshaler, tt.opts.YAMLMarshaler())                 // mismatch yamlMarshaler
+			are.Equal(tt.yamlNode, tt.opts.YAMLNode())                           // mismatch yamlNode
+			are.Equal(tt.unknown, tt.opts.Unknown())                             // mismatch unknown
+			are.Equal(tt.validator, tt.opts.Validator())                         // mismatch validator
+			are.Equal(tt.values, tt.opts.Values())                               // mismatch values
 		})
 	}
 }
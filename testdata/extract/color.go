@@ -0,0 +1,29 @@
+package color
+
+import "fmt"
+
+// Color is a color.
+type Color uint8
+
+// List of colors.
+const (
+	Red Color = iota + 1
+	_
+	Green
+	// Blue is the sky.
+	//
+	// Deprecated: use Sky instead.
+	Blue
+	Sky = Blue + 10
+)
+
+// Shade is not a color.
+type Shade string
+
+// Dark is a shade.
+const Dark Shade = "dark"
+
+// String implements the fmt.Stringer interface.
+func (c Color) String() string {
+	return fmt.Sprint(uint8(c))
+}